@@ -1,6 +1,10 @@
 package anygo
 
-import "fmt"
+import (
+	"errors"
+	"fmt"
+	"reflect"
+)
 
 // Result represents a value of type T or an error.
 type Result[T any] struct {
@@ -19,15 +23,81 @@ func Ok[T any](val T) Result[T] {
 }
 
 // Err returns a failed Result containing an error.
+// If stack traces are enabled, the call stack is recorded with the error.
 //
 // Example:
 //
 //	r := anygo.Err[string](errors.New("oops"))
 //	fmt.Println(r.IsErr()) // true
 func Err[T any](err error) Result[T] {
+	if stackTracesEnabled() {
+		err = errWithStack(err)
+	}
 	return Result[T]{err: err}
 }
 
+// Try wraps a (value, error) pair in a Result: Err if err is not nil, Ok
+// with the value otherwise.
+//
+// Example:
+//
+//	r := anygo.Try(strconv.Atoi("42"))
+//	fmt.Println(r.MustUnwrap()) // 42
+func Try[T any](val T, err error) Result[T] {
+	if err != nil {
+		return Err[T](err)
+	}
+	return Ok(val)
+}
+
+// Wrap1 converts a function returning (T, error) into one returning a Result.
+//
+// Example:
+//
+//	atoi := anygo.Wrap1(strconv.Atoi)
+//	fmt.Println(atoi("42").MustUnwrap()) // 42
+func Wrap1[A, T any](f func(A) (T, error)) func(A) Result[T] {
+	return func(a A) Result[T] {
+		return Try(f(a))
+	}
+}
+
+// Wrap2 converts a two-argument function returning (T, error) into one
+// returning a Result.
+func Wrap2[A, B, T any](f func(A, B) (T, error)) func(A, B) Result[T] {
+	return func(a A, b B) Result[T] {
+		return Try(f(a, b))
+	}
+}
+
+// Wrap3 converts a three-argument function returning (T, error) into one
+// returning a Result.
+func Wrap3[A, B, C, T any](f func(A, B, C) (T, error)) func(A, B, C) Result[T] {
+	return func(a A, b B, c C) Result[T] {
+		return Try(f(a, b, c))
+	}
+}
+
+// FromFunc calls f and wraps its (value, error) return in a Result.
+// A panic inside f is recovered and returned as an Err.
+//
+// Unlike Try, which wraps values that were already computed, the call to f
+// is deferred until FromFunc runs, so FromFunc can be placed inside a
+// closure and re-evaluated on every attempt.
+//
+// Example:
+//
+//	r := anygo.FromFunc(func() (int, error) { return strconv.Atoi("42") })
+//	fmt.Println(r.MustUnwrap()) // 42
+func FromFunc[T any](f func() (T, error)) (r Result[T]) {
+	defer func() {
+		if p := recover(); p != nil {
+			r = Err[T](panicError(p))
+		}
+	}()
+	return Try(f())
+}
+
 // IsOk returns true if the Result has no error.
 //
 // Example:
@@ -59,6 +129,76 @@ func (r Result[T]) Unwrap() (T, error) {
 	return r.value, r.err
 }
 
+// ErrorIs is the same as Is, named for readers who expect the errors
+// vocabulary.
+func (r Result[T]) ErrorIs(target error) bool {
+	return r.Is(target)
+}
+
+// ErrorAs returns the first error in the chain of r that has type E, and
+// true if one was found. It returns false if r is Ok. See errors.As.
+//
+// Example:
+//
+//	if pathErr, ok := anygo.ErrorAs[*fs.PathError](r); ok {
+//		fmt.Println(pathErr.Path)
+//	}
+func ErrorAs[E error, T any](r Result[T]) (E, bool) {
+	var target E
+	if r.IsOk() {
+		return target, false
+	}
+	ok := errors.As(r.err, &target)
+	return target, ok
+}
+
+// UnwrapChecked returns the value and true if ok, or the zero value and false
+// otherwise. The error itself is discarded.
+//
+// Example:
+//
+//	if v, ok := anygo.Ok(5).UnwrapChecked(); ok {
+//		fmt.Println(v) // 5
+//	}
+func (r Result[T]) UnwrapChecked() (value T, ok bool) {
+	return r.value, r.IsOk()
+}
+
+// IsOkAnd returns true if the Result is Ok and pred returns true for the
+// value.
+//
+// Example:
+//
+//	r := anygo.Ok(2)
+//	fmt.Println(r.IsOkAnd(func(x int) bool { return x > 1 })) // true
+func (r Result[T]) IsOkAnd(pred func(T) bool) bool {
+	return r.IsOk() && pred(r.value)
+}
+
+// IsErrAnd returns true if the Result is Err and pred returns true for the
+// error.
+func (r Result[T]) IsErrAnd(pred func(error) bool) bool {
+	return r.IsErr() && pred(r.err)
+}
+
+// ValueOrZero returns the value, or the zero value if Err, together with
+// whether the Result was Ok. It behaves like UnwrapChecked and is named
+// for callers that always use the value, such as template data.
+func (r Result[T]) ValueOrZero() (value T, wasOk bool) {
+	return r.value, r.IsOk()
+}
+
+// Status returns whether the Result is Ok together with its error,
+// without exposing the value.
+//
+// Example:
+//
+//	ok, err := r.Status()
+//	metrics.Record(ok, err)
+func (r Result[T]) Status() (ok bool, err error) {
+	return r.err == nil, r.err
+}
+
 // UnwrapError returns the error if present, or nil if ok.
 func (r Result[T]) UnwrapError() error {
 	if r.IsOk() {
@@ -67,6 +207,39 @@ func (r Result[T]) UnwrapError() error {
 	return r.err
 }
 
+// Is reports whether the Result is Err and its error matches target,
+// as defined by errors.Is.
+//
+// Example:
+//
+//	r := anygo.Err[int](io.EOF)
+//	fmt.Println(r.Is(io.EOF)) // true
+func (r Result[T]) Is(target error) bool {
+	return r.IsErr() && errors.Is(r.err, target)
+}
+
+// As finds the first error in the chain that matches target and, if one is
+// found, sets target to that error value and returns true. It returns false
+// if the Result is Ok. See errors.As.
+func (r Result[T]) As(target any) bool {
+	return r.IsErr() && errors.As(r.err, target)
+}
+
+// UnwrapContext returns the value and nil if ok, or the zero value and the
+// error wrapped with msg otherwise.
+//
+// Example:
+//
+//	v, err := anygo.Err[int](io.EOF).UnwrapContext("read header")
+//	fmt.Println(v, err) // 0 "read header: EOF"
+func (r Result[T]) UnwrapContext(msg string) (T, error) {
+	if r.IsOk() {
+		return r.value, nil
+	}
+	var zero T
+	return zero, fmt.Errorf("%s: %w", msg, r.err)
+}
+
 // UnwrapOr returns the value if ok, or the default otherwise.
 //
 // Example:
@@ -122,6 +295,67 @@ func Map[T any, U any](r Result[T], f func(T) U) Result[U] {
 	return Ok(f(r.value))
 }
 
+// Map2 applies f to the values of a and b if both are ok, or returns the
+// first error in argument order otherwise.
+//
+// Example:
+//
+//	r := anygo.Map2(anygo.Ok("x"), anygo.Ok(1), func(s string, i int) string {
+//		return fmt.Sprint(s, i)
+//	})
+//	fmt.Println(r.MustUnwrap()) // "x1"
+func Map2[A, B, R any](a Result[A], b Result[B], f func(A, B) R) Result[R] {
+	if a.IsErr() {
+		return Err[R](a.err)
+	}
+	if b.IsErr() {
+		return Err[R](b.err)
+	}
+	return Ok(f(a.value, b.value))
+}
+
+// Map3 applies f to the values of a, b and c if all are ok, or returns the
+// first error in argument order otherwise.
+func Map3[A, B, C, R any](a Result[A], b Result[B], c Result[C], f func(A, B, C) R) Result[R] {
+	if a.IsErr() {
+		return Err[R](a.err)
+	}
+	if b.IsErr() {
+		return Err[R](b.err)
+	}
+	if c.IsErr() {
+		return Err[R](c.err)
+	}
+	return Ok(f(a.value, b.value, c.value))
+}
+
+// Zip2 combines a and b into a Pair if both are ok, or returns the first
+// error in argument order otherwise.
+//
+// Example:
+//
+//	r := anygo.Zip2(loadUser(id), loadOrders(id))
+//	p := r.MustUnwrap()
+//	fmt.Println(p.First, p.Second)
+func Zip2[A, B any](a Result[A], b Result[B]) Result[Pair[A, B]] {
+	return Map2(a, b, NewPair[A, B])
+}
+
+// Zip3 combines a, b and c into a Triple if all are ok, or returns the first
+// error in argument order otherwise.
+func Zip3[A, B, C any](a Result[A], b Result[B], c Result[C]) Result[Triple[A, B, C]] {
+	return Map3(a, b, c, NewTriple[A, B, C])
+}
+
+// Zip4 combines a, b, c and d into a Quad if all are ok, or returns the
+// first error in argument order otherwise.
+func Zip4[A, B, C, D any](a Result[A], b Result[B], c Result[C], d Result[D]) Result[Quad[A, B, C, D]] {
+	abc := Zip3(a, b, c)
+	return Map2(abc, d, func(t Triple[A, B, C], d D) Quad[A, B, C, D] {
+		return NewQuad(t.First, t.Second, t.Third, d)
+	})
+}
+
 // MapErr transforms the error if present.
 func (r Result[T]) MapErr(f func(error) error) Result[T] {
 	if r.IsOk() {
@@ -143,6 +377,46 @@ func (r Result[T]) Map(f func(T) T) Result[T] {
 	return Ok(f(r.value))
 }
 
+// MapWhen applies f to the value if Result is Ok and pred returns true for
+// the value. Otherwise the Result is returned unchanged.
+//
+// Example:
+//
+//	r := anygo.Ok(-3).MapWhen(func(x int) bool { return x < 0 }, func(x int) int { return -x })
+//	fmt.Println(r.MustUnwrap()) // 3
+func (r Result[T]) MapWhen(pred func(T) bool, f func(T) T) Result[T] {
+	if r.IsErr() || !pred(r.value) {
+		return r
+	}
+	return Ok(f(r.value))
+}
+
+// FlatMap chains another Result-producing function on success.
+// It is the method form of AndThen for functions that keep the value type.
+//
+// Example:
+//
+//	r := anygo.Ok(4).FlatMap(func(x int) anygo.Result[int] { return sqrt(x) })
+func (r Result[T]) FlatMap(f func(T) Result[T]) Result[T] {
+	if r.IsErr() {
+		return r
+	}
+	return f(r.value)
+}
+
+// Flatten removes one level of nesting: Ok(Ok(v)) becomes Ok(v), and an Err
+// at either level becomes that Err.
+//
+// Example:
+//
+//	r := anygo.Flatten(anygo.Map(parse(s), validate)) // Result[Result[T]] -> Result[T]
+func Flatten[T any](r Result[Result[T]]) Result[T] {
+	if r.IsErr() {
+		return Err[T](r.err)
+	}
+	return r.value
+}
+
 // Inspect calls a function on the value if Result is Ok.
 func (r Result[T]) Inspect(f func(T)) Result[T] {
 	if r.IsOk() {
@@ -151,6 +425,29 @@ func (r Result[T]) Inspect(f func(T)) Result[T] {
 	return r
 }
 
+// InspectErr calls a function on the error if Result is Err.
+//
+// Example:
+//
+//	r := fetch().InspectErr(func(err error) { failures.Inc() })
+func (r Result[T]) InspectErr(f func(error)) Result[T] {
+	if r.IsErr() {
+		f(r.err)
+	}
+	return r
+}
+
+// Tap is Inspect under the name used by pipeline-style code: f runs for its
+// side effect only and the Result is passed through unchanged.
+func (r Result[T]) Tap(f func(T)) Result[T] {
+	return r.Inspect(f)
+}
+
+// TapErr is InspectErr under the name used by pipeline-style code.
+func (r Result[T]) TapErr(f func(error)) Result[T] {
+	return r.InspectErr(f)
+}
+
 // Expect panics with the provided message if Result is Err.
 func (r Result[T]) Expect(msg string) T {
 	if r.IsErr() {
@@ -159,6 +456,15 @@ func (r Result[T]) Expect(msg string) T {
 	return r.value
 }
 
+// ExpectErr returns the error if Result is Err, or panics with the provided
+// message if Result is Ok. It is the mirror of Expect.
+func (r Result[T]) ExpectErr(msg string) error {
+	if r.IsOk() {
+		panic(fmt.Sprintf("%s: %v", msg, r.value))
+	}
+	return r.err
+}
+
 // ToPtr returns a pointer to the value if Ok, or nil if Err.
 func (r Result[T]) ToPtr() *T {
 	if r.IsOk() {
@@ -183,6 +489,28 @@ func (r Result[T]) OrElse(f func() Result[T]) Result[T] {
 	return f()
 }
 
+// Recover returns Ok(val) if Result is Err and its error matches target,
+// as defined by errors.Is. Otherwise the Result is returned unchanged.
+//
+// Example:
+//
+//	r := findUser(id).Recover(sql.ErrNoRows, User{})
+func (r Result[T]) Recover(target error, val T) Result[T] {
+	if r.Is(target) {
+		return Ok(val)
+	}
+	return r
+}
+
+// RecoverWith is like Recover but computes the replacement value from the
+// matched error.
+func (r Result[T]) RecoverWith(target error, f func(error) T) Result[T] {
+	if r.Is(target) {
+		return Ok(f(r.err))
+	}
+	return r
+}
+
 // Errorf adds context to the error if Result is Err.
 func (r Result[T]) Errorf(format string, a ...any) Result[T] {
 	if r.IsOk() {
@@ -191,6 +519,89 @@ func (r Result[T]) Errorf(format string, a ...any) Result[T] {
 	return Err[T](fmt.Errorf("%s: %w", fmt.Sprintf(format, a...), r.err))
 }
 
+// WrapErr adds msg as context to the error if Result is Err.
+// The original error stays in the chain, so errors.Is and errors.Unwrap
+// still reach it.
+//
+// Example:
+//
+//	r := anygo.Err[int](io.EOF).WrapErr("read header")
+//	fmt.Println(r.UnwrapError()) // "read header: EOF"
+func (r Result[T]) WrapErr(msg string) Result[T] {
+	return r.Errorf("%s", msg)
+}
+
+// CloneWith returns a copy of r whose value is produced by clone if Ok.
+// Errors are assumed to be immutable and are shared rather than copied.
+//
+// Example:
+//
+//	cached := anygo.Ok([]int{1, 2})
+//	r := anygo.CloneWith(cached, slices.Clone[[]int])
+func CloneWith[T any](r Result[T], clone func(T) T) Result[T] {
+	if r.IsErr() {
+		return r
+	}
+	return Ok(clone(r.value))
+}
+
+// Match calls ok with the value if Result is Ok, or fail with the error
+// otherwise, and returns what that function returns.
+//
+// Example:
+//
+//	msg := anygo.Match(r,
+//		func(u User) string { return "hello " + u.Name },
+//		func(err error) string { return "error: " + err.Error() },
+//	)
+func Match[T, U any](r Result[T], ok func(T) U, fail func(error) U) U {
+	if r.IsErr() {
+		return fail(r.err)
+	}
+	return ok(r.value)
+}
+
+// Match is the method form of Match for handlers that return T.
+func (r Result[T]) Match(ok func(T) T, fail func(error) T) T {
+	return Match(r, ok, fail)
+}
+
+// Pipe applies each function to the Result in order and returns the final
+// Result. The functions receive and return the whole Result, so each one
+// decides how to handle Err and may observe or recover from it mid-pipe.
+//
+// Example:
+//
+//	r := anygo.Pipe(anygo.Ok(2),
+//		func(r anygo.Result[int]) anygo.Result[int] { return r.Map(double) },
+//		func(r anygo.Result[int]) anygo.Result[int] { return r.WrapErr("double") },
+//	)
+func Pipe[T any](r Result[T], fns ...func(Result[T]) Result[T]) Result[T] {
+	for _, f := range fns {
+		r = f(r)
+	}
+	return r
+}
+
+// AssertType asserts that the value of r has type T. It returns Err
+// describing the mismatch if the assertion fails, and propagates the
+// original error if r is Err.
+//
+// Example:
+//
+//	r := anygo.AssertType[int](anygo.Ok[any](42))
+//	fmt.Println(r.MustUnwrap()) // 42
+func AssertType[T any](r Result[any]) Result[T] {
+	if r.IsErr() {
+		return Err[T](r.err)
+	}
+	val, ok := r.value.(T)
+	if !ok {
+		return Err[T](fmt.Errorf("anygo: value of type %T is not %v", r.value, reflect.TypeFor[T]()))
+	}
+	return Ok(val)
+}
+
 // AndThen chains another Result-producing function on success.
 type andThenFunc[T any, U any] func(T) Result[U]
 
@@ -200,3 +611,19 @@ func AndThen[T any, U any](r Result[T], f andThenFunc[T, U]) Result[U] {
 	}
 	return f(r.value)
 }
+
+// AndThen2 chains two Result-producing functions on success, stopping at the
+// first Err.
+//
+// Example:
+//
+//	r := anygo.AndThen2(anygo.Ok("42"), parseInt, lookupUser)
+func AndThen2[A, B, C any](r Result[A], f func(A) Result[B], g func(B) Result[C]) Result[C] {
+	return AndThen(AndThen(r, f), g)
+}
+
+// AndThen3 chains three Result-producing functions on success, stopping at
+// the first Err.
+func AndThen3[A, B, C, D any](r Result[A], f func(A) Result[B], g func(B) Result[C], h func(C) Result[D]) Result[D] {
+	return AndThen(AndThen2(r, f, g), h)
+}
@@ -3,6 +3,11 @@ package anygo_test
 import (
 	"errors"
 	"fmt"
+	"io"
+	"io/fs"
+	"slices"
+	"strconv"
+	"strings"
 	"testing"
 
 	"github.com/daxartio/anygo"
@@ -23,6 +28,55 @@ func TestErr(t *testing.T) {
 	}
 }
 
+func TestIsOkAnd(t *testing.T) {
+	positive := func(i int) bool { return i > 0 }
+	if !anygo.Ok(1).IsOkAnd(positive) {
+		t.Fatal("expected true for matching Ok")
+	}
+	if anygo.Ok(-1).IsOkAnd(positive) {
+		t.Fatal("expected false for non-matching Ok")
+	}
+	if anygo.Err[int](errors.New("fail")).IsOkAnd(positive) {
+		t.Fatal("expected false for Err")
+	}
+}
+
+func TestIsErrAnd(t *testing.T) {
+	isNotExist := func(err error) bool { return errors.Is(err, fs.ErrNotExist) }
+	if !anygo.Err[int](fs.ErrNotExist).IsErrAnd(isNotExist) {
+		t.Fatal("expected true for matching Err")
+	}
+	if anygo.Err[int](errors.New("other")).IsErrAnd(isNotExist) {
+		t.Fatal("expected false for non-matching Err")
+	}
+	if anygo.Ok(1).IsErrAnd(isNotExist) {
+		t.Fatal("expected false for Ok")
+	}
+}
+
+func TestErrorIs(t *testing.T) {
+	if !anygo.Err[int](fmt.Errorf("x: %w", fs.ErrExist)).ErrorIs(fs.ErrExist) {
+		t.Fatal("expected error to match target")
+	}
+	if anygo.Ok(1).ErrorIs(fs.ErrExist) {
+		t.Fatal("expected Ok result not to match")
+	}
+}
+
+func TestErrorAs(t *testing.T) {
+	r := anygo.Err[int](fmt.Errorf("open: %w", &fs.PathError{Op: "open", Path: "y", Err: fs.ErrNotExist}))
+	pathErr, ok := anygo.ErrorAs[*fs.PathError](r)
+	if !ok || pathErr.Path != "y" {
+		t.Fatal("expected typed error to be extracted")
+	}
+	if _, ok := anygo.ErrorAs[*fs.PathError](anygo.Err[int](errors.New("plain"))); ok {
+		t.Fatal("expected no match for a different error type")
+	}
+	if _, ok := anygo.ErrorAs[*fs.PathError](anygo.Ok(1)); ok {
+		t.Fatal("expected no match for Ok")
+	}
+}
+
 func TestUnwrapOr(t *testing.T) {
 	r := anygo.Err[int](errors.New("fail"))
 	if v := r.UnwrapOr(100); v != 100 {
@@ -53,6 +107,64 @@ func TestMap(t *testing.T) {
 	}
 }
 
+func TestMap2(t *testing.T) {
+	r := anygo.Map2(anygo.Ok("x"), anygo.Ok(1), func(s string, i int) string { return fmt.Sprint(s, i) })
+	if v := r.MustUnwrap(); v != "x1" {
+		t.Fatalf("expected 'x1', got %v", v)
+	}
+
+	first, second := errors.New("first"), errors.New("second")
+	r = anygo.Map2(anygo.Err[string](first), anygo.Err[int](second), func(string, int) string { return "" })
+	if r.UnwrapError() != first {
+		t.Fatal("expected first error in argument order")
+	}
+}
+
+func TestMap3(t *testing.T) {
+	sum := func(a, b, c int) int { return a + b + c }
+	if v := anygo.Map3(anygo.Ok(1), anygo.Ok(2), anygo.Ok(3), sum).MustUnwrap(); v != 6 {
+		t.Fatalf("expected 6, got %d", v)
+	}
+
+	second, third := errors.New("second"), errors.New("third")
+	r := anygo.Map3(anygo.Ok(1), anygo.Err[int](second), anygo.Err[int](third), sum)
+	if r.UnwrapError() != second {
+		t.Fatal("expected first error in argument order")
+	}
+}
+
+func TestZip2(t *testing.T) {
+	p := anygo.Zip2(anygo.Ok("a"), anygo.Ok(1)).MustUnwrap()
+	if p.First != "a" || p.Second != 1 {
+		t.Fatalf("unexpected pair %+v", p)
+	}
+
+	err := errors.New("fail")
+	if r := anygo.Zip2(anygo.Ok("a"), anygo.Err[int](err)); r.UnwrapError() != err {
+		t.Fatal("expected error")
+	}
+}
+
+func TestZip3(t *testing.T) {
+	tr := anygo.Zip3(anygo.Ok(1), anygo.Ok("b"), anygo.Ok(true)).MustUnwrap()
+	if tr.First != 1 || tr.Second != "b" || !tr.Third {
+		t.Fatalf("unexpected triple %+v", tr)
+	}
+}
+
+func TestZip4(t *testing.T) {
+	q := anygo.Zip4(anygo.Ok(1), anygo.Ok(2), anygo.Ok(3), anygo.Ok(4)).MustUnwrap()
+	if q.First != 1 || q.Second != 2 || q.Third != 3 || q.Fourth != 4 {
+		t.Fatalf("unexpected quad %+v", q)
+	}
+
+	first, last := errors.New("first"), errors.New("last")
+	r := anygo.Zip4(anygo.Ok(1), anygo.Err[int](first), anygo.Ok(3), anygo.Err[int](last))
+	if r.UnwrapError() != first {
+		t.Fatal("expected first error in argument order")
+	}
+}
+
 func TestResultMap(t *testing.T) {
 	r := anygo.Ok(3)
 	mapped := r.Map(func(i int) int { return i + 1 })
@@ -81,6 +193,54 @@ func TestAndThen(t *testing.T) {
 	}
 }
 
+func TestFlatten(t *testing.T) {
+	if v := anygo.Flatten(anygo.Ok(anygo.Ok(1))).MustUnwrap(); v != 1 {
+		t.Fatalf("expected 1, got %d", v)
+	}
+	outer, inner := errors.New("outer"), errors.New("inner")
+	if err := anygo.Flatten(anygo.Err[anygo.Result[int]](outer)).UnwrapError(); err != outer {
+		t.Fatalf("expected outer error, got %v", err)
+	}
+	if err := anygo.Flatten(anygo.Ok(anygo.Err[int](inner))).UnwrapError(); err != inner {
+		t.Fatalf("expected inner error, got %v", err)
+	}
+}
+
+func TestFlatMap(t *testing.T) {
+	half := func(i int) anygo.Result[int] {
+		if i%2 != 0 {
+			return anygo.Err[int](errors.New("odd"))
+		}
+		return anygo.Ok(i / 2)
+	}
+	if v := anygo.Ok(8).FlatMap(half).FlatMap(half).MustUnwrap(); v != 2 {
+		t.Fatalf("expected 2, got %d", v)
+	}
+	if r := anygo.Ok(6).FlatMap(half).FlatMap(half); !r.IsErr() {
+		t.Fatal("expected Err result")
+	}
+}
+
+func TestAndThen2(t *testing.T) {
+	atoi := anygo.Wrap1(strconv.Atoi)
+	double := func(i int) anygo.Result[string] { return anygo.Ok(strconv.Itoa(i * 2)) }
+	if v := anygo.AndThen2(anygo.Ok("21"), atoi, double).MustUnwrap(); v != "42" {
+		t.Fatalf("expected '42', got %q", v)
+	}
+	if r := anygo.AndThen2(anygo.Ok("x"), atoi, double); !r.IsErr() {
+		t.Fatal("expected Err result")
+	}
+}
+
+func TestAndThen3(t *testing.T) {
+	atoi := anygo.Wrap1(strconv.Atoi)
+	itoa := func(i int) anygo.Result[string] { return anygo.Ok(strconv.Itoa(i + 1)) }
+	length := func(s string) anygo.Result[int] { return anygo.Ok(len(s)) }
+	if v := anygo.AndThen3(anygo.Ok("99"), atoi, itoa, length).MustUnwrap(); v != 3 {
+		t.Fatalf("expected 3, got %d", v)
+	}
+}
+
 func TestInspect(t *testing.T) {
 	r := anygo.Ok("value")
 	called := false
@@ -95,6 +255,34 @@ func TestInspect(t *testing.T) {
 	}
 }
 
+func TestInspectErr(t *testing.T) {
+	err := errors.New("boom")
+	var seen error
+	anygo.Ok(1).InspectErr(func(e error) { seen = e })
+	if seen != nil {
+		t.Fatal("expected InspectErr not to run on Ok")
+	}
+	r := anygo.Err[int](err).InspectErr(func(e error) { seen = e })
+	if seen != err || r.UnwrapError() != err {
+		t.Fatal("expected InspectErr to see the error and pass the Result through")
+	}
+}
+
+func TestTap(t *testing.T) {
+	var values, errs int
+	anygo.Ok(1).
+		Tap(func(int) { values++ }).
+		TapErr(func(error) { errs++ }).
+		Map(func(i int) int { return i + 1 }).
+		Tap(func(int) { values++ })
+	anygo.Err[int](errors.New("boom")).
+		Tap(func(int) { values++ }).
+		TapErr(func(error) { errs++ })
+	if values != 2 || errs != 1 {
+		t.Fatalf("unexpected side effects: %d values, %d errors", values, errs)
+	}
+}
+
 func TestExpect(t *testing.T) {
 	defer func() {
 		if r := recover(); r == nil {
@@ -104,6 +292,20 @@ func TestExpect(t *testing.T) {
 	anygo.Err[int](errors.New("fail")).Expect("should not fail")
 }
 
+func TestExpectErr(t *testing.T) {
+	err := errors.New("fail")
+	if got := anygo.Err[int](err).ExpectErr("should fail"); got != err {
+		t.Fatal("expected original error")
+	}
+
+	defer func() {
+		if r := recover(); r != "should fail: 1" {
+			t.Fatalf("unexpected panic %v", r)
+		}
+	}()
+	anygo.Ok(1).ExpectErr("should fail")
+}
+
 func TestToPtr(t *testing.T) {
 	r := anygo.Ok(123)
 	ptr := r.ToPtr()
@@ -142,3 +344,273 @@ func TestErrorf(t *testing.T) {
 		t.Fatalf("expected error message '%s', got '%s'", expectedMsg, resErr)
 	}
 }
+
+func TestTry(t *testing.T) {
+	if v := anygo.Try(strconv.Atoi("42")).MustUnwrap(); v != 42 {
+		t.Fatalf("expected 42, got %d", v)
+	}
+	if r := anygo.Try(strconv.Atoi("x")); !r.IsErr() {
+		t.Fatal("expected Err result")
+	}
+}
+
+func TestWrap(t *testing.T) {
+	atoi := anygo.Wrap1(strconv.Atoi)
+	if v := atoi("42").MustUnwrap(); v != 42 {
+		t.Fatalf("expected 42, got %d", v)
+	}
+
+	parseInt := anygo.Wrap3(strconv.ParseInt)
+	if v := parseInt("ff", 16, 64).MustUnwrap(); v != 255 {
+		t.Fatalf("expected 255, got %d", v)
+	}
+
+	div := anygo.Wrap2(func(a, b int) (int, error) {
+		if b == 0 {
+			return 0, errors.New("division by zero")
+		}
+		return a / b, nil
+	})
+	if r := div(1, 0); !r.IsErr() {
+		t.Fatal("expected Err result")
+	}
+}
+
+func TestFromFunc(t *testing.T) {
+	r := anygo.FromFunc(func() (int, error) { return 7, nil })
+	if v := r.MustUnwrap(); v != 7 {
+		t.Fatalf("expected 7, got %d", v)
+	}
+
+	err := errors.New("scan failed")
+	r = anygo.FromFunc(func() (int, error) { return 0, err })
+	if r.UnwrapError() != err {
+		t.Fatal("expected original error")
+	}
+}
+
+func TestFromFuncRecoversPanic(t *testing.T) {
+	r := anygo.FromFunc(func() (int, error) { panic("boom") })
+	if !r.IsErr() {
+		t.Fatal("expected Err result after panic")
+	}
+}
+
+func TestIs(t *testing.T) {
+	r := anygo.Err[int](fmt.Errorf("read: %w", fs.ErrNotExist))
+	if !r.Is(fs.ErrNotExist) {
+		t.Fatal("expected error to match target")
+	}
+	if anygo.Ok(1).Is(fs.ErrNotExist) {
+		t.Fatal("expected Ok result not to match")
+	}
+}
+
+func TestAs(t *testing.T) {
+	r := anygo.Err[int](fmt.Errorf("open: %w", &fs.PathError{Op: "open", Path: "x", Err: fs.ErrNotExist}))
+	var pathErr *fs.PathError
+	if !r.As(&pathErr) || pathErr.Path != "x" {
+		t.Fatal("expected error to be extracted")
+	}
+	if anygo.Ok(1).As(&pathErr) {
+		t.Fatal("expected Ok result not to match")
+	}
+}
+
+func TestUnwrapChecked(t *testing.T) {
+	if v, ok := anygo.Ok(5).UnwrapChecked(); !ok || v != 5 {
+		t.Fatalf("expected 5 and true, got %d and %v", v, ok)
+	}
+	if v, ok := anygo.Err[int](errors.New("fail")).UnwrapChecked(); ok || v != 0 {
+		t.Fatalf("expected 0 and false, got %d and %v", v, ok)
+	}
+}
+
+func TestWrapErr(t *testing.T) {
+	sentinel := errors.New("not found")
+	res := anygo.Err[int](sentinel).WrapErr("load user")
+	err := res.UnwrapError()
+	if err.Error() != "load user: not found" {
+		t.Fatalf("unexpected error message '%s'", err)
+	}
+	if !errors.Is(err, sentinel) {
+		t.Fatal("expected wrapped error to match sentinel")
+	}
+	if errors.Unwrap(err) != sentinel {
+		t.Fatal("expected errors.Unwrap to return the original error")
+	}
+	if v := anygo.Ok(1).WrapErr("ctx").MustUnwrap(); v != 1 {
+		t.Fatal("expected Ok to pass through")
+	}
+}
+
+func TestValueOrZero(t *testing.T) {
+	if v, ok := anygo.Ok("x").ValueOrZero(); !ok || v != "x" {
+		t.Fatalf("expected 'x' and true, got %q and %v", v, ok)
+	}
+	if v, ok := anygo.Err[string](errors.New("fail")).ValueOrZero(); ok || v != "" {
+		t.Fatalf("expected zero value and false, got %q and %v", v, ok)
+	}
+}
+
+func TestStatus(t *testing.T) {
+	if ok, err := anygo.Ok("v").Status(); !ok || err != nil {
+		t.Fatal("expected Ok status")
+	}
+	fail := errors.New("fail")
+	if ok, err := anygo.Err[string](fail).Status(); ok || err != fail {
+		t.Fatal("expected Err status")
+	}
+}
+
+func TestCloneWith(t *testing.T) {
+	orig := anygo.Ok([]int{1, 2})
+	clone := anygo.CloneWith(orig, slices.Clone[[]int])
+	clone.MustUnwrap()[0] = 99
+	if orig.MustUnwrap()[0] != 1 {
+		t.Fatal("expected original value to be untouched")
+	}
+
+	err := errors.New("fail")
+	if r := anygo.CloneWith(anygo.Err[[]int](err), slices.Clone[[]int]); r.UnwrapError() != err {
+		t.Fatal("expected error to be shared")
+	}
+}
+
+func TestMapWhen(t *testing.T) {
+	negative := func(x int) bool { return x < 0 }
+	abs := func(x int) int { return -x }
+	if v := anygo.Ok(-3).MapWhen(negative, abs).MustUnwrap(); v != 3 {
+		t.Fatalf("expected 3, got %d", v)
+	}
+	if v := anygo.Ok(4).MapWhen(negative, abs).MustUnwrap(); v != 4 {
+		t.Fatalf("expected 4, got %d", v)
+	}
+	called := false
+	anygo.Err[int](errors.New("fail")).MapWhen(func(int) bool { called = true; return true }, abs)
+	if called {
+		t.Fatal("expected predicate not to be called on Err")
+	}
+}
+
+func TestPipe(t *testing.T) {
+	double := func(r anygo.Result[int]) anygo.Result[int] {
+		return r.Map(func(i int) int { return i * 2 })
+	}
+	if v := anygo.Pipe(anygo.Ok(2), double, double).MustUnwrap(); v != 8 {
+		t.Fatalf("expected 8, got %d", v)
+	}
+
+	fallback := func(r anygo.Result[int]) anygo.Result[int] { return r.Or(anygo.Ok(1)) }
+	res := anygo.Pipe(anygo.Err[int](errors.New("fail")), double, fallback, double)
+	if v := res.MustUnwrap(); v != 2 {
+		t.Fatalf("expected recovery mid-pipe, got %d", v)
+	}
+}
+
+func TestUnwrapContext(t *testing.T) {
+	if v, err := anygo.Ok(1).UnwrapContext("load"); v != 1 || err != nil {
+		t.Fatalf("expected 1 and nil, got %d and %v", v, err)
+	}
+
+	sentinel := errors.New("not found")
+	v, err := anygo.Err[int](sentinel).UnwrapContext("load user")
+	if v != 0 || err.Error() != "load user: not found" {
+		t.Fatalf("unexpected result %d, '%v'", v, err)
+	}
+	if !errors.Is(err, sentinel) {
+		t.Fatal("expected error to wrap the original")
+	}
+}
+
+func TestMustUnwrapOkDoesNotAllocate(t *testing.T) {
+	r := anygo.Ok(42)
+	if n := testing.AllocsPerRun(100, func() { _ = r.MustUnwrap() }); n != 0 {
+		t.Fatalf("expected no allocations, got %v", n)
+	}
+}
+
+func BenchmarkMustUnwrapOk(b *testing.B) {
+	r := anygo.Ok(42)
+	b.ReportAllocs()
+	for b.Loop() {
+		_ = r.MustUnwrap()
+	}
+}
+
+func TestRecover(t *testing.T) {
+	r := anygo.Err[int](fmt.Errorf("query: %w", fs.ErrNotExist)).Recover(fs.ErrNotExist, 0)
+	if v := r.MustUnwrap(); v != 0 {
+		t.Fatalf("expected 0, got %d", v)
+	}
+
+	other := errors.New("other")
+	if r := anygo.Err[int](other).Recover(fs.ErrNotExist, 0); r.UnwrapError() != other {
+		t.Fatal("expected unmatched error to pass through")
+	}
+}
+
+func TestRecoverWith(t *testing.T) {
+	r := anygo.Err[string](fs.ErrNotExist).RecoverWith(fs.ErrNotExist, func(err error) string {
+		return err.Error()
+	})
+	if v := r.MustUnwrap(); v != fs.ErrNotExist.Error() {
+		t.Fatalf("unexpected value %q", v)
+	}
+}
+
+func TestAssertType(t *testing.T) {
+	if v := anygo.AssertType[int](anygo.Ok[any](42)).MustUnwrap(); v != 42 {
+		t.Fatalf("expected 42, got %d", v)
+	}
+
+	err := errors.New("fail")
+	if r := anygo.AssertType[int](anygo.Err[any](err)); r.UnwrapError() != err {
+		t.Fatal("expected original error")
+	}
+}
+
+func TestAssertTypeMismatch(t *testing.T) {
+	r := anygo.AssertType[int](anygo.Ok[any]("42"))
+	if !r.IsErr() {
+		t.Fatal("expected Err on type mismatch")
+	}
+	if msg := r.UnwrapError().Error(); msg != "anygo: value of type string is not int" {
+		t.Fatalf("unexpected message '%s'", msg)
+	}
+}
+
+func TestAssertTypeInterface(t *testing.T) {
+	if _, err := anygo.AssertType[io.Reader](anygo.Ok[any](strings.NewReader("x"))).Unwrap(); err != nil {
+		t.Fatalf("expected *strings.Reader to satisfy io.Reader, got %v", err)
+	}
+	r := anygo.AssertType[io.Reader](anygo.Ok[any](42))
+	if msg := r.UnwrapError().Error(); msg != "anygo: value of type int is not io.Reader" {
+		t.Fatalf("unexpected message '%s'", msg)
+	}
+}
+
+func TestMatch(t *testing.T) {
+	describe := func(r anygo.Result[int]) string {
+		return anygo.Match(r,
+			func(i int) string { return "ok " + strconv.Itoa(i) },
+			func(err error) string { return "err " + err.Error() },
+		)
+	}
+	if s := describe(anygo.Ok(1)); s != "ok 1" {
+		t.Fatalf("unexpected %q", s)
+	}
+	if s := describe(anygo.Err[int](errors.New("bad"))); s != "err bad" {
+		t.Fatalf("unexpected %q", s)
+	}
+}
+
+func TestResultMatch(t *testing.T) {
+	v := anygo.Err[int](errors.New("bad")).Match(
+		func(i int) int { return i },
+		func(error) int { return -1 },
+	)
+	if v != -1 {
+		t.Fatalf("expected -1, got %d", v)
+	}
+}
@@ -0,0 +1,56 @@
+package anygo
+
+import "sync/atomic"
+
+// Atomic is a typed wrapper around atomic.Value. Unlike atomic.Value, it
+// accepts any T, including interface types holding values of different
+// concrete types. The zero value holds the zero value of T.
+type Atomic[T any] struct {
+	v atomic.Value
+}
+
+// atomicBox gives atomic.Value a single concrete type to store.
+type atomicBox[T any] struct {
+	v T
+}
+
+// NewAtomic returns an Atomic holding v.
+//
+// Example:
+//
+//	cfg := anygo.NewAtomic(loadConfig())
+//	go watch(func(c Config) { cfg.Store(c) })
+//	current := cfg.Load()
+func NewAtomic[T any](v T) *Atomic[T] {
+	a := &Atomic[T]{}
+	a.Store(v)
+	return a
+}
+
+// Load returns the current value.
+func (a *Atomic[T]) Load() T {
+	b, _ := a.v.Load().(atomicBox[T])
+	return b.v
+}
+
+// Store sets the value to v.
+func (a *Atomic[T]) Store(v T) {
+	a.v.Store(atomicBox[T]{v})
+}
+
+// Swap sets the value to v and returns the previous value.
+func (a *Atomic[T]) Swap(v T) T {
+	b, _ := a.v.Swap(atomicBox[T]{v}).(atomicBox[T])
+	return b.v
+}
+
+// CompareAndSwap sets the value to new if the current value equals old and
+// reports whether it did. It panics if T is not comparable at run time.
+func (a *Atomic[T]) CompareAndSwap(old, new T) bool {
+	if a.v.CompareAndSwap(atomicBox[T]{old}, atomicBox[T]{new}) {
+		return true
+	}
+	// Nothing stored yet: the current value is the zero value of T.
+	var zero T
+	return any(old) == any(zero) && a.v.CompareAndSwap(nil, atomicBox[T]{new})
+}
@@ -0,0 +1,66 @@
+package anygo_test
+
+import (
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/daxartio/anygo"
+)
+
+func TestAtomic(t *testing.T) {
+	a := anygo.NewAtomic(1)
+	if a.Load() != 1 {
+		t.Fatal("expected 1")
+	}
+	if old := a.Swap(2); old != 1 || a.Load() != 2 {
+		t.Fatalf("unexpected swap result %d", old)
+	}
+	if a.CompareAndSwap(1, 3) {
+		t.Fatal("expected CompareAndSwap to fail on mismatch")
+	}
+	if !a.CompareAndSwap(2, 3) || a.Load() != 3 {
+		t.Fatal("expected CompareAndSwap to succeed")
+	}
+}
+
+func TestAtomicZeroValue(t *testing.T) {
+	var a anygo.Atomic[string]
+	if a.Load() != "" {
+		t.Fatal("expected zero value")
+	}
+	if !a.CompareAndSwap("", "x") || a.Load() != "x" {
+		t.Fatal("expected CompareAndSwap from the zero value to succeed")
+	}
+}
+
+func TestAtomicInterface(t *testing.T) {
+	var a anygo.Atomic[error]
+	a.Store(errors.New("a"))
+	a.Store(&anygo.PanicError{Value: "b"})
+	a.Store(nil)
+	if a.Load() != nil {
+		t.Fatal("expected nil error")
+	}
+}
+
+func TestAtomicConcurrent(t *testing.T) {
+	a := anygo.NewAtomic(0)
+	var wg sync.WaitGroup
+	for range 50 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for {
+				v := a.Load()
+				if a.CompareAndSwap(v, v+1) {
+					return
+				}
+			}
+		}()
+	}
+	wg.Wait()
+	if a.Load() != 50 {
+		t.Fatalf("expected 50, got %d", a.Load())
+	}
+}
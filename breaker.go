@@ -0,0 +1,165 @@
+package anygo
+
+import (
+	"errors"
+	"sync"
+	"time"
+)
+
+// ErrCircuitOpen is returned by Breaker.Do while the circuit is open.
+var ErrCircuitOpen = errors.New("anygo: circuit open")
+
+// BreakerState is the state of a Breaker.
+type BreakerState int
+
+const (
+	// BreakerClosed lets every call through.
+	BreakerClosed BreakerState = iota
+	// BreakerOpen rejects every call with ErrCircuitOpen.
+	BreakerOpen
+	// BreakerHalfOpen lets a single trial call through to probe for
+	// recovery.
+	BreakerHalfOpen
+)
+
+func (s BreakerState) String() string {
+	switch s {
+	case BreakerClosed:
+		return "closed"
+	case BreakerOpen:
+		return "open"
+	case BreakerHalfOpen:
+		return "half-open"
+	default:
+		return "unknown"
+	}
+}
+
+// BreakerConfig configures a Breaker.
+type BreakerConfig struct {
+	// FailureThreshold is the number of consecutive failures that opens
+	// the circuit. Values below 1 mean a single failure.
+	FailureThreshold int
+	// OpenTimeout is how long the circuit stays open before a trial call
+	// is allowed.
+	OpenTimeout time.Duration
+	// IsFailure reports whether an error counts as a failure. If nil,
+	// every error does.
+	IsFailure func(error) bool
+}
+
+// Breaker is a circuit breaker for Result-producing calls. After
+// FailureThreshold consecutive failures it opens and rejects calls until
+// OpenTimeout has passed, then lets one trial call through: success closes
+// the circuit, failure opens it again. It is safe for concurrent use.
+type Breaker[T any] struct {
+	cfg      BreakerConfig
+	mu       sync.Mutex
+	state    BreakerState
+	failures int
+	openedAt time.Time
+	trial    bool   // a half-open trial call is in flight
+	gen      uint64 // incremented on every state change
+}
+
+// NewBreaker returns a closed Breaker.
+//
+// Example:
+//
+//	b := anygo.NewBreaker[*Response](anygo.BreakerConfig{
+//		FailureThreshold: 5,
+//		OpenTimeout:      30 * time.Second,
+//	})
+//	r := b.Do(func() anygo.Result[*Response] { return call(ctx, req) })
+//	if errors.Is(r.UnwrapError(), anygo.ErrCircuitOpen) {
+//		// fail fast without calling the backend
+//	}
+func NewBreaker[T any](cfg BreakerConfig) *Breaker[T] {
+	cfg.FailureThreshold = max(1, cfg.FailureThreshold)
+	return &Breaker[T]{cfg: cfg}
+}
+
+// Do calls f unless the circuit is open, in which case it returns
+// Err(ErrCircuitOpen) without calling f. If f panics, the call counts as a
+// failure and the panic is propagated.
+func (b *Breaker[T]) Do(f func() Result[T]) Result[T] {
+	gen, ok := b.allow()
+	if !ok {
+		return Err[T](ErrCircuitOpen)
+	}
+	failed := true
+	defer func() { b.record(gen, failed) }()
+	r := f()
+	failed = r.IsErr() && (b.cfg.IsFailure == nil || b.cfg.IsFailure(r.err))
+	return r
+}
+
+// State returns the current state of the circuit.
+func (b *Breaker[T]) State() BreakerState {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.state == BreakerOpen && time.Since(b.openedAt) >= b.cfg.OpenTimeout {
+		return BreakerHalfOpen
+	}
+	return b.state
+}
+
+// allow reports whether a call may proceed and returns the generation it
+// was admitted in.
+func (b *Breaker[T]) allow() (uint64, bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	switch b.state {
+	case BreakerOpen:
+		if time.Since(b.openedAt) < b.cfg.OpenTimeout {
+			return 0, false
+		}
+		b.setState(BreakerHalfOpen)
+	case BreakerHalfOpen:
+		if b.trial {
+			return 0, false
+		}
+	default:
+		return b.gen, true
+	}
+	b.trial = true
+	return b.gen, true
+}
+
+// record applies the outcome of a call admitted in generation gen. Calls
+// that finish after the state has changed are ignored, so only the trial
+// call can settle a half-open circuit.
+func (b *Breaker[T]) record(gen uint64, failed bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if gen != b.gen {
+		return
+	}
+	if b.state == BreakerHalfOpen {
+		b.trial = false
+		if failed {
+			b.open()
+		} else {
+			b.setState(BreakerClosed)
+		}
+		return
+	}
+	if !failed {
+		b.failures = 0
+		return
+	}
+	b.failures++
+	if b.failures >= b.cfg.FailureThreshold {
+		b.open()
+	}
+}
+
+func (b *Breaker[T]) open() {
+	b.setState(BreakerOpen)
+	b.openedAt = time.Now()
+}
+
+func (b *Breaker[T]) setState(s BreakerState) {
+	b.state, b.failures = s, 0
+	b.gen++
+}
@@ -0,0 +1,138 @@
+package anygo_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/daxartio/anygo"
+)
+
+func TestBreakerOpensAfterThreshold(t *testing.T) {
+	b := anygo.NewBreaker[int](anygo.BreakerConfig{FailureThreshold: 2, OpenTimeout: time.Hour})
+	boom := errors.New("boom")
+	fail := func() anygo.Result[int] { return anygo.Err[int](boom) }
+	b.Do(fail)
+	if b.State() != anygo.BreakerClosed {
+		t.Fatal("expected closed after one failure")
+	}
+	b.Do(fail)
+	if b.State() != anygo.BreakerOpen {
+		t.Fatalf("expected open, got %v", b.State())
+	}
+	calls := 0
+	r := b.Do(func() anygo.Result[int] {
+		calls++
+		return anygo.Ok(1)
+	})
+	if !errors.Is(r.UnwrapError(), anygo.ErrCircuitOpen) || calls != 0 {
+		t.Fatalf("expected ErrCircuitOpen without calling f, got %v", r)
+	}
+}
+
+func TestBreakerSuccessResetsFailures(t *testing.T) {
+	b := anygo.NewBreaker[int](anygo.BreakerConfig{FailureThreshold: 2, OpenTimeout: time.Hour})
+	fail := func() anygo.Result[int] { return anygo.Err[int](errors.New("boom")) }
+	b.Do(fail)
+	b.Do(func() anygo.Result[int] { return anygo.Ok(1) })
+	b.Do(fail)
+	if b.State() != anygo.BreakerClosed {
+		t.Fatal("expected success to reset the failure count")
+	}
+}
+
+func TestBreakerHalfOpen(t *testing.T) {
+	b := anygo.NewBreaker[int](anygo.BreakerConfig{OpenTimeout: 10 * time.Millisecond})
+	fail := func() anygo.Result[int] { return anygo.Err[int](errors.New("boom")) }
+	b.Do(fail)
+	time.Sleep(20 * time.Millisecond)
+	if b.State() != anygo.BreakerHalfOpen {
+		t.Fatalf("expected half-open, got %v", b.State())
+	}
+	b.Do(fail)
+	if b.State() != anygo.BreakerOpen {
+		t.Fatalf("expected a failed trial to reopen, got %v", b.State())
+	}
+	time.Sleep(20 * time.Millisecond)
+	if v := b.Do(func() anygo.Result[int] { return anygo.Ok(7) }).MustUnwrap(); v != 7 {
+		t.Fatalf("expected trial value 7, got %d", v)
+	}
+	if b.State() != anygo.BreakerClosed {
+		t.Fatalf("expected a successful trial to close, got %v", b.State())
+	}
+}
+
+func TestBreakerIgnoresStaleCalls(t *testing.T) {
+	b := anygo.NewBreaker[int](anygo.BreakerConfig{OpenTimeout: time.Hour})
+	started, release, done := make(chan struct{}), make(chan struct{}), make(chan struct{})
+	go func() {
+		defer close(done)
+		b.Do(func() anygo.Result[int] {
+			close(started)
+			<-release
+			return anygo.Ok(1)
+		})
+	}()
+	<-started
+	b.Do(func() anygo.Result[int] { return anygo.Err[int](errors.New("boom")) })
+	if b.State() != anygo.BreakerOpen {
+		t.Fatalf("expected open, got %v", b.State())
+	}
+	close(release)
+	<-done
+	if b.State() != anygo.BreakerOpen {
+		t.Fatalf("expected a call from before the circuit opened to be ignored, got %v", b.State())
+	}
+}
+
+func TestBreakerHalfOpenOnlyTrialCloses(t *testing.T) {
+	b := anygo.NewBreaker[int](anygo.BreakerConfig{OpenTimeout: 10 * time.Millisecond})
+	started, release, done := make(chan struct{}), make(chan struct{}), make(chan struct{})
+	go func() {
+		defer close(done)
+		b.Do(func() anygo.Result[int] {
+			close(started)
+			<-release
+			return anygo.Ok(1)
+		})
+	}()
+	<-started
+	b.Do(func() anygo.Result[int] { return anygo.Err[int](errors.New("boom")) })
+	time.Sleep(20 * time.Millisecond)
+
+	trialStarted, trialRelease, trialDone := make(chan struct{}), make(chan struct{}), make(chan struct{})
+	go func() {
+		defer close(trialDone)
+		b.Do(func() anygo.Result[int] {
+			close(trialStarted)
+			<-trialRelease
+			return anygo.Err[int](errors.New("still down"))
+		})
+	}()
+	<-trialStarted
+	close(release)
+	<-done
+	if b.State() != anygo.BreakerHalfOpen {
+		t.Fatalf("expected a stale call not to settle the trial, got %v", b.State())
+	}
+	close(trialRelease)
+	<-trialDone
+	if b.State() != anygo.BreakerOpen {
+		t.Fatalf("expected the failed trial to reopen, got %v", b.State())
+	}
+}
+
+func TestBreakerIsFailure(t *testing.T) {
+	notFound := errors.New("not found")
+	b := anygo.NewBreaker[int](anygo.BreakerConfig{
+		OpenTimeout: time.Hour,
+		IsFailure:   func(err error) bool { return !errors.Is(err, notFound) },
+	})
+	b.Do(func() anygo.Result[int] { return anygo.Err[int](notFound) })
+	if b.State() != anygo.BreakerClosed {
+		t.Fatal("expected ignored errors not to open the circuit")
+	}
+	if anygo.BreakerHalfOpen.String() != "half-open" {
+		t.Fatal("unexpected state name")
+	}
+}
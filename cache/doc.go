@@ -0,0 +1,5 @@
+// Package cache provides generic in-memory caches that integrate with
+// anygo's Result type.
+//
+// All caches in this package are safe for concurrent use.
+package cache
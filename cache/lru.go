@@ -0,0 +1,201 @@
+package cache
+
+import (
+	"sync"
+	"time"
+
+	"github.com/daxartio/anygo"
+)
+
+// Stats reports cache lookup counters.
+type Stats struct {
+	Hits      uint64
+	Misses    uint64
+	Evictions uint64
+}
+
+// LRU is a fixed-capacity cache that evicts the least recently used entry
+// when full. Entries may optionally expire after a per-entry TTL.
+type LRU[K comparable, V any] struct {
+	mu         sync.Mutex
+	capacity   int
+	index      map[K]*lruEntry[K, V]
+	head, tail *lruEntry[K, V] // head is the most recently used
+	stats      Stats
+}
+
+type lruEntry[K comparable, V any] struct {
+	key        K
+	value      V
+	expires    time.Time // zero means no expiry
+	prev, next *lruEntry[K, V]
+}
+
+// NewLRU returns an empty LRU holding at most capacity entries. It panics if
+// capacity is less than 1.
+//
+// Example:
+//
+//	c := cache.NewLRU[string, *User](1024)
+//	c.Set("alice", alice)
+//	u, ok := c.Get("alice")
+func NewLRU[K comparable, V any](capacity int) *LRU[K, V] {
+	if capacity < 1 {
+		panic("cache: LRU capacity must be at least 1")
+	}
+	return &LRU[K, V]{capacity: capacity, index: make(map[K]*lruEntry[K, V])}
+}
+
+// Get returns the value for k and whether it was present, marking it as
+// recently used. Expired entries are removed and reported as missing.
+func (c *LRU[K, V]) Get(k K) (V, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	e, ok := c.lookup(k)
+	if !ok {
+		c.stats.Misses++
+		var zero V
+		return zero, false
+	}
+	c.stats.Hits++
+	c.moveToFront(e)
+	return e.value, true
+}
+
+// Peek returns the value for k like Get, but without marking it as recently
+// used or updating the stats.
+func (c *LRU[K, V]) Peek(k K) (V, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if e, ok := c.lookup(k); ok {
+		return e.value, true
+	}
+	var zero V
+	return zero, false
+}
+
+// Set stores v under k without expiry, evicting the least recently used
+// entry if the cache is full.
+func (c *LRU[K, V]) Set(k K, v V) {
+	c.set(k, v, time.Time{})
+}
+
+// SetWithTTL stores v under k like Set, but the entry expires after ttl.
+func (c *LRU[K, V]) SetWithTTL(k K, v V, ttl time.Duration) {
+	c.set(k, v, time.Now().Add(ttl))
+}
+
+// Remove deletes k and reports whether it was present.
+func (c *LRU[K, V]) Remove(k K) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	e, ok := c.index[k]
+	if ok {
+		c.unlink(e)
+	}
+	return ok
+}
+
+// Len returns the number of entries, including expired entries that have not
+// been looked up since they expired.
+func (c *LRU[K, V]) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return len(c.index)
+}
+
+// Stats returns a snapshot of the lookup counters.
+func (c *LRU[K, V]) Stats() Stats {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.stats
+}
+
+// GetOrCompute returns the cached value for k as Ok, or calls f and caches
+// its value if f returns Ok. Err Results are returned but not cached.
+//
+// f runs without holding the cache lock, so concurrent misses for the same
+// key may each call f.
+//
+// Example:
+//
+//	u := c.GetOrCompute(id, func() anygo.Result[*User] {
+//		return anygo.Wrap1(db.LoadUser)(id)
+//	})
+func (c *LRU[K, V]) GetOrCompute(k K, f func() anygo.Result[V]) anygo.Result[V] {
+	if v, ok := c.Get(k); ok {
+		return anygo.Ok(v)
+	}
+	r := f()
+	if v, err := r.Unwrap(); err == nil {
+		c.Set(k, v)
+	}
+	return r
+}
+
+func (c *LRU[K, V]) set(k K, v V, expires time.Time) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if e, ok := c.index[k]; ok {
+		e.value, e.expires = v, expires
+		c.moveToFront(e)
+		return
+	}
+	if len(c.index) >= c.capacity {
+		c.unlink(c.tail)
+		c.stats.Evictions++
+	}
+	e := &lruEntry[K, V]{key: k, value: v, expires: expires}
+	c.pushFront(e)
+	c.index[k] = e
+}
+
+// lookup returns the live entry for k, dropping it if it has expired.
+func (c *LRU[K, V]) lookup(k K) (*lruEntry[K, V], bool) {
+	e, ok := c.index[k]
+	if !ok {
+		return nil, false
+	}
+	if !e.expires.IsZero() && !time.Now().Before(e.expires) {
+		c.unlink(e)
+		return nil, false
+	}
+	return e, true
+}
+
+func (c *LRU[K, V]) pushFront(e *lruEntry[K, V]) {
+	e.prev, e.next = nil, c.head
+	if c.head != nil {
+		c.head.prev = e
+	} else {
+		c.tail = e
+	}
+	c.head = e
+}
+
+func (c *LRU[K, V]) moveToFront(e *lruEntry[K, V]) {
+	if e == c.head {
+		return
+	}
+	c.detach(e)
+	c.pushFront(e)
+}
+
+func (c *LRU[K, V]) unlink(e *lruEntry[K, V]) {
+	c.detach(e)
+	delete(c.index, e.key)
+}
+
+func (c *LRU[K, V]) detach(e *lruEntry[K, V]) {
+	if e.prev != nil {
+		e.prev.next = e.next
+	} else {
+		c.head = e.next
+	}
+	if e.next != nil {
+		e.next.prev = e.prev
+	} else {
+		c.tail = e.prev
+	}
+	e.prev, e.next = nil, nil
+}
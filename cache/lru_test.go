@@ -0,0 +1,101 @@
+package cache_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/daxartio/anygo"
+	"github.com/daxartio/anygo/cache"
+)
+
+func TestLRUEvictsLeastRecentlyUsed(t *testing.T) {
+	c := cache.NewLRU[string, int](2)
+	c.Set("a", 1)
+	c.Set("b", 2)
+	c.Get("a")
+	c.Set("c", 3)
+	if _, ok := c.Peek("b"); ok {
+		t.Fatal("expected b to be evicted")
+	}
+	if v, ok := c.Get("a"); !ok || v != 1 {
+		t.Fatalf("expected a=1, got %v %v", v, ok)
+	}
+	if c.Len() != 2 || c.Stats().Evictions != 1 {
+		t.Fatalf("unexpected len %d stats %+v", c.Len(), c.Stats())
+	}
+}
+
+func TestLRUPeekDoesNotPromote(t *testing.T) {
+	c := cache.NewLRU[string, int](2)
+	c.Set("a", 1)
+	c.Set("b", 2)
+	c.Peek("a")
+	c.Set("c", 3)
+	if _, ok := c.Peek("a"); ok {
+		t.Fatal("expected a to be evicted")
+	}
+	if s := c.Stats(); s.Hits != 0 || s.Misses != 0 {
+		t.Fatalf("expected Peek not to count, got %+v", s)
+	}
+}
+
+func TestLRURemoveAndStats(t *testing.T) {
+	c := cache.NewLRU[int, string](4)
+	c.Set(1, "one")
+	if !c.Remove(1) || c.Remove(1) {
+		t.Fatal("expected Remove to report presence once")
+	}
+	c.Get(1)
+	c.Set(2, "two")
+	c.Get(2)
+	if s := c.Stats(); s.Hits != 1 || s.Misses != 1 {
+		t.Fatalf("unexpected stats %+v", s)
+	}
+}
+
+func TestLRUTTL(t *testing.T) {
+	c := cache.NewLRU[string, int](2)
+	c.SetWithTTL("a", 1, 10*time.Millisecond)
+	if _, ok := c.Get("a"); !ok {
+		t.Fatal("expected a before expiry")
+	}
+	time.Sleep(20 * time.Millisecond)
+	if _, ok := c.Get("a"); ok {
+		t.Fatal("expected a to expire")
+	}
+	if c.Len() != 0 {
+		t.Fatalf("expected expired entry to be dropped, len %d", c.Len())
+	}
+}
+
+func TestLRUGetOrCompute(t *testing.T) {
+	c := cache.NewLRU[string, int](2)
+	calls := 0
+	compute := func() anygo.Result[int] {
+		calls++
+		return anygo.Ok(42)
+	}
+	c.GetOrCompute("a", compute)
+	if r := c.GetOrCompute("a", compute); r.MustUnwrap() != 42 || calls != 1 {
+		t.Fatalf("expected cached 42 after one call, got %v after %d", r, calls)
+	}
+
+	boom := errors.New("boom")
+	r := c.GetOrCompute("b", func() anygo.Result[int] { return anygo.Err[int](boom) })
+	if !errors.Is(r.UnwrapError(), boom) {
+		t.Fatalf("expected boom, got %v", r)
+	}
+	if _, ok := c.Peek("b"); ok {
+		t.Fatal("expected Err not to be cached")
+	}
+}
+
+func TestNewLRUPanicsOnZeroCapacity(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Fatal("expected panic")
+		}
+	}()
+	cache.NewLRU[string, int](0)
+}
@@ -0,0 +1,159 @@
+package cache
+
+import (
+	"sync"
+	"time"
+)
+
+// TTLConfig configures a TTL cache.
+type TTLConfig[K comparable, V any] struct {
+	// DefaultTTL is the lifetime of entries stored with Set. Zero or
+	// negative means entries do not expire.
+	DefaultTTL time.Duration
+	// CleanupInterval is how often a background goroutine removes expired
+	// entries. Zero means expired entries are only removed lazily, when
+	// they are looked up or when EvictExpired is called.
+	CleanupInterval time.Duration
+	// OnEvict, if set, is called with every entry removed because it
+	// expired or was removed with Remove. It runs without holding the
+	// cache lock.
+	OnEvict func(K, V)
+}
+
+// TTL is a cache whose entries expire after a time-to-live. Unlike LRU, it
+// has no capacity limit.
+type TTL[K comparable, V any] struct {
+	mu      sync.Mutex
+	cfg     TTLConfig[K, V]
+	entries map[K]ttlEntry[V]
+	stop    chan struct{}
+	once    sync.Once
+}
+
+type ttlEntry[V any] struct {
+	value   V
+	expires time.Time // zero means no expiry
+}
+
+// NewTTL returns an empty TTL cache. If cfg.CleanupInterval is positive, a
+// background goroutine evicts expired entries until Close is called.
+//
+// Example:
+//
+//	c := cache.NewTTL(cache.TTLConfig[string, *Session]{
+//		DefaultTTL:      30 * time.Minute,
+//		CleanupInterval: time.Minute,
+//	})
+//	defer c.Close()
+func NewTTL[K comparable, V any](cfg TTLConfig[K, V]) *TTL[K, V] {
+	c := &TTL[K, V]{
+		cfg:     cfg,
+		entries: make(map[K]ttlEntry[V]),
+		stop:    make(chan struct{}),
+	}
+	if cfg.CleanupInterval > 0 {
+		go c.janitor(cfg.CleanupInterval)
+	}
+	return c
+}
+
+// Get returns the value for k and whether it is present and not expired.
+func (c *TTL[K, V]) Get(k K) (V, bool) {
+	c.mu.Lock()
+	e, ok := c.entries[k]
+	if ok && e.expired(time.Now()) {
+		delete(c.entries, k)
+		c.mu.Unlock()
+		c.evicted(k, e.value)
+		var zero V
+		return zero, false
+	}
+	c.mu.Unlock()
+	return e.value, ok
+}
+
+// Set stores v under k with the default TTL.
+func (c *TTL[K, V]) Set(k K, v V) {
+	c.SetWithTTL(k, v, c.cfg.DefaultTTL)
+}
+
+// SetWithTTL stores v under k, expiring after ttl. Zero or negative ttl
+// means the entry does not expire.
+func (c *TTL[K, V]) SetWithTTL(k K, v V, ttl time.Duration) {
+	e := ttlEntry[V]{value: v}
+	if ttl > 0 {
+		e.expires = time.Now().Add(ttl)
+	}
+	c.mu.Lock()
+	c.entries[k] = e
+	c.mu.Unlock()
+}
+
+// Remove deletes k and reports whether it was present.
+func (c *TTL[K, V]) Remove(k K) bool {
+	c.mu.Lock()
+	e, ok := c.entries[k]
+	delete(c.entries, k)
+	c.mu.Unlock()
+	if ok {
+		c.evicted(k, e.value)
+	}
+	return ok
+}
+
+// Len returns the number of entries, including expired entries that have not
+// been evicted yet.
+func (c *TTL[K, V]) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return len(c.entries)
+}
+
+// EvictExpired removes all expired entries.
+func (c *TTL[K, V]) EvictExpired() {
+	now := time.Now()
+	var expired map[K]V
+	c.mu.Lock()
+	for k, e := range c.entries {
+		if e.expired(now) {
+			if expired == nil {
+				expired = make(map[K]V)
+			}
+			expired[k] = e.value
+			delete(c.entries, k)
+		}
+	}
+	c.mu.Unlock()
+	for k, v := range expired {
+		c.evicted(k, v)
+	}
+}
+
+// Close stops the background cleanup goroutine, if any. The cache remains
+// usable with lazy eviction. Close is safe to call more than once.
+func (c *TTL[K, V]) Close() {
+	c.once.Do(func() { close(c.stop) })
+}
+
+func (c *TTL[K, V]) janitor(interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			c.EvictExpired()
+		case <-c.stop:
+			return
+		}
+	}
+}
+
+func (c *TTL[K, V]) evicted(k K, v V) {
+	if c.cfg.OnEvict != nil {
+		c.cfg.OnEvict(k, v)
+	}
+}
+
+func (e ttlEntry[V]) expired(now time.Time) bool {
+	return !e.expires.IsZero() && !now.Before(e.expires)
+}
@@ -0,0 +1,81 @@
+package cache_test
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/daxartio/anygo/cache"
+)
+
+func TestTTLLazyExpiration(t *testing.T) {
+	var evicted []string
+	c := cache.NewTTL(cache.TTLConfig[string, int]{
+		DefaultTTL: 10 * time.Millisecond,
+		OnEvict:    func(k string, _ int) { evicted = append(evicted, k) },
+	})
+	defer c.Close()
+	c.Set("a", 1)
+	c.SetWithTTL("b", 2, 0)
+	if v, ok := c.Get("a"); !ok || v != 1 {
+		t.Fatalf("expected a=1, got %v %v", v, ok)
+	}
+	time.Sleep(20 * time.Millisecond)
+	if c.Len() != 2 {
+		t.Fatalf("expected lazy eviction to keep entries until looked up, len %d", c.Len())
+	}
+	if _, ok := c.Get("a"); ok {
+		t.Fatal("expected a to expire")
+	}
+	if _, ok := c.Get("b"); !ok {
+		t.Fatal("expected b to never expire")
+	}
+	if len(evicted) != 1 || evicted[0] != "a" {
+		t.Fatalf("unexpected evictions %v", evicted)
+	}
+}
+
+func TestTTLBackgroundEviction(t *testing.T) {
+	var (
+		mu      sync.Mutex
+		evicted int
+	)
+	c := cache.NewTTL(cache.TTLConfig[int, int]{
+		DefaultTTL:      5 * time.Millisecond,
+		CleanupInterval: 5 * time.Millisecond,
+		OnEvict: func(int, int) {
+			mu.Lock()
+			evicted++
+			mu.Unlock()
+		},
+	})
+	defer c.Close()
+	c.Set(1, 1)
+	c.Set(2, 2)
+	deadline := time.Now().Add(time.Second)
+	for c.Len() > 0 && time.Now().Before(deadline) {
+		time.Sleep(5 * time.Millisecond)
+	}
+	mu.Lock()
+	defer mu.Unlock()
+	if c.Len() != 0 || evicted != 2 {
+		t.Fatalf("expected background eviction of 2 entries, len %d evicted %d", c.Len(), evicted)
+	}
+}
+
+func TestTTLRemoveAndEvictExpired(t *testing.T) {
+	evicted := 0
+	c := cache.NewTTL(cache.TTLConfig[string, int]{OnEvict: func(string, int) { evicted++ }})
+	c.Close()
+	c.Close()
+	c.Set("a", 1)
+	c.SetWithTTL("b", 2, time.Millisecond)
+	if !c.Remove("a") || c.Remove("a") {
+		t.Fatal("expected Remove to report presence once")
+	}
+	time.Sleep(5 * time.Millisecond)
+	c.EvictExpired()
+	if c.Len() != 0 || evicted != 2 {
+		t.Fatalf("expected both entries evicted, len %d evicted %d", c.Len(), evicted)
+	}
+}
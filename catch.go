@@ -0,0 +1,60 @@
+package anygo
+
+import (
+	"fmt"
+	"runtime/debug"
+)
+
+// PanicError is the error produced when a panic is recovered and converted
+// into an Err.
+type PanicError struct {
+	// Value is the value passed to panic.
+	Value any
+	// Stack is the goroutine stack at the point of recovery.
+	Stack []byte
+}
+
+func (e *PanicError) Error() string {
+	return fmt.Sprintf("anygo: panic: %v", e.Value)
+}
+
+// Unwrap returns the panic value if it is an error.
+func (e *PanicError) Unwrap() error {
+	err, _ := e.Value.(error)
+	return err
+}
+
+// panicError converts a recovered panic value into a *PanicError.
+func panicError(p any) error {
+	return &PanicError{Value: p, Stack: debug.Stack()}
+}
+
+// Catch calls f and returns its value as an Ok Result. If f panics, the
+// panic is recovered and returned as an Err holding a *PanicError.
+//
+// Example:
+//
+//	r := anygo.Catch(func() int { return mustParse(input) })
+//	var perr *anygo.PanicError
+//	if r.As(&perr) {
+//		log.Printf("%v\n%s", perr.Value, perr.Stack)
+//	}
+func Catch[T any](f func() T) (r Result[T]) {
+	defer func() {
+		if p := recover(); p != nil {
+			r = Err[T](panicError(p))
+		}
+	}()
+	return Ok(f())
+}
+
+// Catch0 calls f and returns a *PanicError if it panics, or nil otherwise.
+func Catch0(f func()) (err error) {
+	defer func() {
+		if p := recover(); p != nil {
+			err = panicError(p)
+		}
+	}()
+	f()
+	return nil
+}
@@ -0,0 +1,44 @@
+package anygo_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/daxartio/anygo"
+)
+
+func TestCatch(t *testing.T) {
+	if v := anygo.Catch(func() int { return 1 }).MustUnwrap(); v != 1 {
+		t.Fatalf("expected 1, got %d", v)
+	}
+
+	r := anygo.Catch(func() int { panic("boom") })
+	var perr *anygo.PanicError
+	if !r.As(&perr) {
+		t.Fatalf("expected PanicError, got %v", r.UnwrapError())
+	}
+	if perr.Value != "boom" || len(perr.Stack) == 0 {
+		t.Fatalf("unexpected panic error %+v", perr)
+	}
+	if perr.Error() != "anygo: panic: boom" {
+		t.Fatalf("unexpected message '%s'", perr)
+	}
+}
+
+func TestCatchErrorValue(t *testing.T) {
+	sentinel := errors.New("sentinel")
+	r := anygo.Catch(func() int { panic(sentinel) })
+	if !r.Is(sentinel) {
+		t.Fatal("expected panic error value to be unwrappable")
+	}
+}
+
+func TestCatch0(t *testing.T) {
+	if err := anygo.Catch0(func() {}); err != nil {
+		t.Fatalf("expected nil, got %v", err)
+	}
+	var perr *anygo.PanicError
+	if err := anygo.Catch0(func() { panic(1) }); !errors.As(err, &perr) || perr.Value != 1 {
+		t.Fatalf("expected PanicError, got %v", err)
+	}
+}
@@ -0,0 +1,168 @@
+// Package chanx provides context-aware generic channel utilities.
+//
+// Every function starts goroutines that stop, and close their output
+// channels, when the inputs are exhausted or the context is done. Callers
+// should either drain the outputs or cancel the context.
+package chanx
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// Merge forwards the values of every input channel to a single output
+// channel. The output is closed once all inputs are closed or ctx is done.
+//
+// Example:
+//
+//	for v := range chanx.Merge(ctx, a, b) {
+//		fmt.Println(v)
+//	}
+func Merge[T any](ctx context.Context, chs ...<-chan T) <-chan T {
+	out := make(chan T)
+	var wg sync.WaitGroup
+	for _, ch := range chs {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			forward(ctx, ch, out)
+		}()
+	}
+	go func() {
+		wg.Wait()
+		close(out)
+	}()
+	return out
+}
+
+// FanOut distributes the values of ch across n output channels. Each value
+// is delivered to exactly one output, whichever is ready first. The outputs
+// are closed once ch is closed or ctx is done. It panics if n is less
+// than 1.
+func FanOut[T any](ctx context.Context, ch <-chan T, n int) []<-chan T {
+	if n < 1 {
+		panic("chanx: FanOut needs at least 1 output")
+	}
+	outs := make([]<-chan T, n)
+	for i := range n {
+		out := make(chan T)
+		outs[i] = out
+		go func() {
+			defer close(out)
+			forward(ctx, ch, out)
+		}()
+	}
+	return outs
+}
+
+// Batch groups the values of ch into slices of up to size values. A partial
+// batch is emitted once maxWait has passed since its first value, and when
+// ch is closed. The output is closed once ch is closed or ctx is done; a
+// partial batch pending when ctx is done is dropped. It panics if size is
+// less than 1.
+//
+// Example:
+//
+//	for rows := range chanx.Batch(ctx, rowsCh, 100, time.Second) {
+//		db.InsertAll(rows)
+//	}
+func Batch[T any](ctx context.Context, ch <-chan T, size int, maxWait time.Duration) <-chan []T {
+	if size < 1 {
+		panic("chanx: Batch needs a size of at least 1")
+	}
+	out := make(chan []T)
+	go func() {
+		defer close(out)
+		var (
+			batch []T
+			timer *time.Timer
+			tick  <-chan time.Time
+		)
+		flush := func() bool {
+			if timer != nil {
+				timer.Stop()
+				tick = nil
+			}
+			if len(batch) == 0 {
+				return true
+			}
+			b := batch
+			batch = nil
+			select {
+			case out <- b:
+				return true
+			case <-ctx.Done():
+				return false
+			}
+		}
+		for {
+			select {
+			case v, ok := <-ch:
+				if !ok {
+					flush()
+					return
+				}
+				batch = append(batch, v)
+				if len(batch) == 1 {
+					timer = time.NewTimer(maxWait)
+					tick = timer.C
+				}
+				if len(batch) >= size && !flush() {
+					return
+				}
+			case <-tick:
+				if !flush() {
+					return
+				}
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return out
+}
+
+// Take forwards at most n values of ch to the output channel. The output is
+// closed after n values, or once ch is closed or ctx is done.
+func Take[T any](ctx context.Context, ch <-chan T, n int) <-chan T {
+	out := make(chan T)
+	go func() {
+		defer close(out)
+		for range n {
+			select {
+			case v, ok := <-ch:
+				if !ok {
+					return
+				}
+				select {
+				case out <- v:
+				case <-ctx.Done():
+					return
+				}
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return out
+}
+
+// forward copies values from in to out until in is closed or ctx is done.
+func forward[T any](ctx context.Context, in <-chan T, out chan<- T) {
+	for {
+		select {
+		case v, ok := <-in:
+			if !ok {
+				return
+			}
+			select {
+			case out <- v:
+			case <-ctx.Done():
+				return
+			}
+		case <-ctx.Done():
+			return
+		}
+	}
+}
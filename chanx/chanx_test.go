@@ -0,0 +1,130 @@
+package chanx_test
+
+import (
+	"context"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/daxartio/anygo/chanx"
+)
+
+func source(values ...int) <-chan int {
+	ch := make(chan int)
+	go func() {
+		defer close(ch)
+		for _, v := range values {
+			ch <- v
+		}
+	}()
+	return ch
+}
+
+func collect[T any](ch <-chan T) []T {
+	var out []T
+	for v := range ch {
+		out = append(out, v)
+	}
+	return out
+}
+
+func TestMerge(t *testing.T) {
+	got := collect(chanx.Merge(context.Background(), source(1, 2), source(3)))
+	slices.Sort(got)
+	if !slices.Equal(got, []int{1, 2, 3}) {
+		t.Fatalf("expected [1 2 3], got %v", got)
+	}
+}
+
+func TestMergeContextDone(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	out := chanx.Merge(ctx, make(chan int))
+	cancel()
+	if _, ok := <-out; ok {
+		t.Fatal("expected output to close on cancel")
+	}
+}
+
+func TestFanOut(t *testing.T) {
+	outs := chanx.FanOut(context.Background(), source(1, 2, 3, 4), 2)
+	var (
+		mu  sync.Mutex
+		got []int
+		wg  sync.WaitGroup
+	)
+	for _, out := range outs {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for v := range out {
+				mu.Lock()
+				got = append(got, v)
+				mu.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+	slices.Sort(got)
+	if !slices.Equal(got, []int{1, 2, 3, 4}) {
+		t.Fatalf("expected each value exactly once, got %v", got)
+	}
+}
+
+func TestFanOutPanicsOnInvalidCount(t *testing.T) {
+	for _, n := range []int{0, -1} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Fatalf("expected panic for n=%d", n)
+				}
+			}()
+			chanx.FanOut(context.Background(), make(chan int), n)
+		}()
+	}
+}
+
+func TestBatchPanicsOnInvalidSize(t *testing.T) {
+	for _, size := range []int{0, -1} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Fatalf("expected panic for size=%d", size)
+				}
+			}()
+			chanx.Batch(context.Background(), make(chan int), size, time.Second)
+		}()
+	}
+}
+
+func TestBatch(t *testing.T) {
+	got := collect(chanx.Batch(context.Background(), source(1, 2, 3, 4, 5), 2, time.Hour))
+	if len(got) != 3 || !slices.Equal(got[0], []int{1, 2}) || !slices.Equal(got[2], []int{5}) {
+		t.Fatalf("unexpected batches %v", got)
+	}
+}
+
+func TestBatchMaxWait(t *testing.T) {
+	ch := make(chan int)
+	out := chanx.Batch(context.Background(), ch, 10, 5*time.Millisecond)
+	ch <- 1
+	select {
+	case b := <-out:
+		if !slices.Equal(b, []int{1}) {
+			t.Fatalf("unexpected batch %v", b)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("expected partial batch after maxWait")
+	}
+	close(ch)
+}
+
+func TestTake(t *testing.T) {
+	got := collect(chanx.Take(context.Background(), source(1, 2, 3), 2))
+	if !slices.Equal(got, []int{1, 2}) {
+		t.Fatalf("expected [1 2], got %v", got)
+	}
+	if got := collect(chanx.Take(context.Background(), source(1), 5)); !slices.Equal(got, []int{1}) {
+		t.Fatalf("expected [1], got %v", got)
+	}
+}
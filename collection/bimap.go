@@ -0,0 +1,146 @@
+package collection
+
+import "slices"
+
+// BiMap is a one-to-one map that can be looked up by key or by value. Every
+// key maps to exactly one value and every value to exactly one key.
+type BiMap[K, V comparable] struct {
+	forward  map[K]V
+	backward map[V]K
+}
+
+// NewBiMap returns an empty BiMap.
+//
+// Example:
+//
+//	ids := collection.NewBiMap[int, string]()
+//	ids.Insert(1, "alice")
+//	name, _ := ids.Get(1)        // "alice"
+//	id, _ := ids.GetKey("alice") // 1
+func NewBiMap[K, V comparable]() *BiMap[K, V] {
+	return &BiMap[K, V]{forward: make(map[K]V), backward: make(map[V]K)}
+}
+
+// Insert adds the pair k, v and reports whether it did. It does nothing and
+// returns false if k or v is already present.
+func (m *BiMap[K, V]) Insert(k K, v V) bool {
+	if _, ok := m.forward[k]; ok {
+		return false
+	}
+	if _, ok := m.backward[v]; ok {
+		return false
+	}
+	m.forward[k], m.backward[v] = v, k
+	return true
+}
+
+// Set adds the pair k, v, first removing any pair that has key k or value v.
+func (m *BiMap[K, V]) Set(k K, v V) {
+	m.DeleteKey(k)
+	m.DeleteValue(v)
+	m.forward[k], m.backward[v] = v, k
+}
+
+// Get returns the value for k and whether it was present.
+func (m *BiMap[K, V]) Get(k K) (V, bool) {
+	v, ok := m.forward[k]
+	return v, ok
+}
+
+// GetKey returns the key for v and whether it was present.
+func (m *BiMap[K, V]) GetKey(v V) (K, bool) {
+	k, ok := m.backward[v]
+	return k, ok
+}
+
+// DeleteKey removes the pair with key k and reports whether it was present.
+func (m *BiMap[K, V]) DeleteKey(k K) bool {
+	v, ok := m.forward[k]
+	if ok {
+		delete(m.forward, k)
+		delete(m.backward, v)
+	}
+	return ok
+}
+
+// DeleteValue removes the pair with value v and reports whether it was
+// present.
+func (m *BiMap[K, V]) DeleteValue(v V) bool {
+	k, ok := m.backward[v]
+	if ok {
+		delete(m.backward, v)
+		delete(m.forward, k)
+	}
+	return ok
+}
+
+// Len returns the number of pairs.
+func (m *BiMap[K, V]) Len() int {
+	return len(m.forward)
+}
+
+// MultiMap maps each key to an ordered list of distinct values.
+type MultiMap[K, V comparable] struct {
+	m map[K][]V
+}
+
+// NewMultiMap returns an empty MultiMap.
+//
+// Example:
+//
+//	tags := collection.NewMultiMap[string, int]()
+//	tags.Add("go", 1)
+//	tags.Add("go", 2)
+//	fmt.Println(tags.Get("go")) // [1 2]
+func NewMultiMap[K, V comparable]() *MultiMap[K, V] {
+	return &MultiMap[K, V]{m: make(map[K][]V)}
+}
+
+// Add appends v to the values of k and reports whether it did. It does
+// nothing and returns false if k already has v.
+func (m *MultiMap[K, V]) Add(k K, v V) bool {
+	if slices.Contains(m.m[k], v) {
+		return false
+	}
+	m.m[k] = append(m.m[k], v)
+	return true
+}
+
+// Get returns a copy of the values of k in the order they were added, or
+// nil if k has none.
+func (m *MultiMap[K, V]) Get(k K) []V {
+	return slices.Clone(m.m[k])
+}
+
+// Has reports whether k has the value v.
+func (m *MultiMap[K, V]) Has(k K, v V) bool {
+	return slices.Contains(m.m[k], v)
+}
+
+// DeleteValue removes v from the values of k and reports whether it was
+// present. A key left without values is removed.
+func (m *MultiMap[K, V]) DeleteValue(k K, v V) bool {
+	vs := m.m[k]
+	i := slices.Index(vs, v)
+	if i < 0 {
+		return false
+	}
+	if len(vs) == 1 {
+		delete(m.m, k)
+	} else {
+		m.m[k] = slices.Delete(vs, i, i+1)
+	}
+	return true
+}
+
+// Delete removes k with all its values and reports whether it was present.
+func (m *MultiMap[K, V]) Delete(k K) bool {
+	_, ok := m.m[k]
+	delete(m.m, k)
+	return ok
+}
+
+// Len returns the number of keys.
+func (m *MultiMap[K, V]) Len() int {
+	return len(m.m)
+}
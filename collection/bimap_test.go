@@ -0,0 +1,55 @@
+package collection_test
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/daxartio/anygo/collection"
+)
+
+func TestBiMap(t *testing.T) {
+	m := collection.NewBiMap[int, string]()
+	if !m.Insert(1, "alice") || m.Insert(1, "bob") || m.Insert(2, "alice") {
+		t.Fatal("expected Insert to enforce unique keys and values")
+	}
+	if v, ok := m.Get(1); !ok || v != "alice" {
+		t.Fatalf("expected alice, got '%s'", v)
+	}
+	if k, ok := m.GetKey("alice"); !ok || k != 1 {
+		t.Fatalf("expected 1, got %d", k)
+	}
+	m.Insert(2, "bob")
+	m.Set(1, "bob")
+	if m.Len() != 1 {
+		t.Fatalf("expected Set to replace both conflicting pairs, got %d pairs", m.Len())
+	}
+	if _, ok := m.GetKey("alice"); ok {
+		t.Fatal("expected alice to be removed")
+	}
+	if !m.DeleteValue("bob") || m.DeleteKey(1) || m.Len() != 0 {
+		t.Fatal("unexpected delete results")
+	}
+}
+
+func TestMultiMap(t *testing.T) {
+	m := collection.NewMultiMap[string, int]()
+	m.Add("go", 1)
+	m.Add("go", 2)
+	if m.Add("go", 1) {
+		t.Fatal("expected duplicate value to be rejected")
+	}
+	m.Add("rust", 3)
+	if got := m.Get("go"); !slices.Equal(got, []int{1, 2}) || !m.Has("go", 2) {
+		t.Fatalf("expected [1 2], got %v", got)
+	}
+	if !m.DeleteValue("go", 1) || m.DeleteValue("go", 1) {
+		t.Fatal("expected DeleteValue to report presence once")
+	}
+	m.DeleteValue("go", 2)
+	if m.Get("go") != nil || m.Len() != 1 {
+		t.Fatal("expected an empty key to be removed")
+	}
+	if !m.Delete("rust") || m.Len() != 0 {
+		t.Fatal("expected Delete to remove the key")
+	}
+}
@@ -0,0 +1,143 @@
+package collection
+
+import (
+	"cmp"
+	"iter"
+	"maps"
+	"slices"
+
+	"github.com/daxartio/anygo"
+)
+
+// DefaultMap is a map that creates missing values on access with a factory
+// function.
+type DefaultMap[K comparable, V any] struct {
+	m       map[K]V
+	factory func() V
+}
+
+// NewDefaultMap returns an empty DefaultMap that calls factory for every key
+// read with Get before it was set.
+//
+// Example:
+//
+//	byTag := collection.NewDefaultMap[string](func() collection.Set[int] {
+//		return collection.NewSet[int]()
+//	})
+//	byTag.Get("go").Add(42)
+func NewDefaultMap[K comparable, V any](factory func() V) *DefaultMap[K, V] {
+	return &DefaultMap[K, V]{m: make(map[K]V), factory: factory}
+}
+
+// Get returns the value for k, storing a new value from the factory first
+// if k is missing.
+func (m *DefaultMap[K, V]) Get(k K) V {
+	v, ok := m.m[k]
+	if !ok {
+		v = m.factory()
+		m.m[k] = v
+	}
+	return v
+}
+
+// Lookup returns the value for k and whether it was present, without
+// creating it.
+func (m *DefaultMap[K, V]) Lookup(k K) (V, bool) {
+	v, ok := m.m[k]
+	return v, ok
+}
+
+// Set stores v under k.
+func (m *DefaultMap[K, V]) Set(k K, v V) {
+	m.m[k] = v
+}
+
+// Delete removes k and reports whether it was present.
+func (m *DefaultMap[K, V]) Delete(k K) bool {
+	_, ok := m.m[k]
+	delete(m.m, k)
+	return ok
+}
+
+// Len returns the number of entries.
+func (m *DefaultMap[K, V]) Len() int {
+	return len(m.m)
+}
+
+// All returns an iterator over the entries, in no particular order.
+func (m *DefaultMap[K, V]) All() iter.Seq2[K, V] {
+	return maps.All(m.m)
+}
+
+// Counter counts occurrences of keys. The zero value is an empty counter
+// ready to use.
+type Counter[K comparable] struct {
+	counts map[K]int
+	order  []K // keys in first-seen order, to break ties deterministically
+}
+
+// NewCounter returns a Counter with each key counted once per occurrence.
+//
+// Example:
+//
+//	c := collection.NewCounter(strings.Fields(text)...)
+//	for _, p := range c.MostCommon(3) {
+//		fmt.Println(p.First, p.Second)
+//	}
+func NewCounter[K comparable](keys ...K) *Counter[K] {
+	c := &Counter[K]{}
+	for _, k := range keys {
+		c.Inc(k)
+	}
+	return c
+}
+
+// Inc adds one to the count of k.
+func (c *Counter[K]) Inc(k K) {
+	c.Add(k, 1)
+}
+
+// Add adds n to the count of k.
+func (c *Counter[K]) Add(k K, n int) {
+	if c.counts == nil {
+		c.counts = make(map[K]int)
+	}
+	if _, ok := c.counts[k]; !ok {
+		c.order = append(c.order, k)
+	}
+	c.counts[k] += n
+}
+
+// Get returns the count of k, or zero if it was never counted.
+func (c *Counter[K]) Get(k K) int {
+	return c.counts[k]
+}
+
+// Len returns the number of distinct keys.
+func (c *Counter[K]) Len() int {
+	return len(c.counts)
+}
+
+// Total returns the sum of all counts.
+func (c *Counter[K]) Total() int {
+	total := 0
+	for _, n := range c.counts {
+		total += n
+	}
+	return total
+}
+
+// MostCommon returns the n keys with the highest counts, highest first, as
+// key and count pairs. Keys with equal counts keep the order in which they
+// were first counted. A negative n, or one above Len, returns every key.
+func (c *Counter[K]) MostCommon(n int) []anygo.Pair[K, int] {
+	out := make([]anygo.Pair[K, int], len(c.order))
+	for i, k := range c.order {
+		out[i] = anygo.NewPair(k, c.counts[k])
+	}
+	slices.SortStableFunc(out, func(a, b anygo.Pair[K, int]) int { return cmp.Compare(b.Second, a.Second) })
+	if n >= 0 && n < len(out) {
+		out = out[:n]
+	}
+	return out
+}
@@ -0,0 +1,55 @@
+package collection_test
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/daxartio/anygo"
+	"github.com/daxartio/anygo/collection"
+)
+
+func TestDefaultMap(t *testing.T) {
+	calls := 0
+	m := collection.NewDefaultMap[string](func() *[]int {
+		calls++
+		return &[]int{}
+	})
+	*m.Get("a") = append(*m.Get("a"), 1)
+	*m.Get("a") = append(*m.Get("a"), 2)
+	if got := *m.Get("a"); !slices.Equal(got, []int{1, 2}) || calls != 1 {
+		t.Fatalf("expected [1 2] from a single factory call, got %v after %d", got, calls)
+	}
+	if _, ok := m.Lookup("b"); ok || m.Len() != 1 {
+		t.Fatal("expected Lookup not to create entries")
+	}
+	m.Set("b", &[]int{3})
+	count := 0
+	for range m.All() {
+		count++
+	}
+	if count != 2 || !m.Delete("b") || m.Delete("b") {
+		t.Fatal("unexpected entries or Delete result")
+	}
+}
+
+func TestCounter(t *testing.T) {
+	c := collection.NewCounter("b", "a", "b", "c", "a", "b")
+	c.Add("c", 1)
+	c.Inc("d")
+	if c.Get("b") != 3 || c.Get("missing") != 0 || c.Len() != 4 || c.Total() != 8 {
+		t.Fatalf("unexpected counts %+v", c.MostCommon(-1))
+	}
+	want := []anygo.Pair[string, int]{anygo.NewPair("b", 3), anygo.NewPair("a", 2), anygo.NewPair("c", 2)}
+	if got := c.MostCommon(3); !slices.Equal(got, want) {
+		t.Fatalf("expected %v, got %v", want, got)
+	}
+	if got := c.MostCommon(10); len(got) != 4 {
+		t.Fatalf("expected every key, got %v", got)
+	}
+
+	var zero collection.Counter[int]
+	zero.Inc(1)
+	if zero.Get(1) != 1 {
+		t.Fatal("expected zero value Counter to be usable")
+	}
+}
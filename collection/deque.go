@@ -0,0 +1,101 @@
+package collection
+
+import "github.com/daxartio/anygo"
+
+// Deque is a double-ended queue backed by a growable circular slice. The
+// zero value is an empty deque ready to use.
+type Deque[T any] struct {
+	buf  []T
+	head int // index of the front item in buf
+	n    int
+}
+
+// NewDeque returns a Deque holding items, from front to back.
+//
+// Example:
+//
+//	d := collection.NewDeque(2, 3)
+//	d.PushFront(1)
+//	d.PushBack(4)
+//	front, _ := d.PopFront().Unwrap() // 1
+//	back, _ := d.PopBack().Unwrap()   // 4
+func NewDeque[T any](items ...T) *Deque[T] {
+	return &Deque[T]{buf: append([]T(nil), items...), n: len(items)}
+}
+
+// PushFront adds v at the front.
+func (d *Deque[T]) PushFront(v T) {
+	d.grow()
+	d.head = (d.head - 1 + len(d.buf)) % len(d.buf)
+	d.buf[d.head] = v
+	d.n++
+}
+
+// PushBack adds v at the back.
+func (d *Deque[T]) PushBack(v T) {
+	d.grow()
+	d.buf[(d.head+d.n)%len(d.buf)] = v
+	d.n++
+}
+
+// PopFront removes and returns the front item, or None if the deque is
+// empty.
+func (d *Deque[T]) PopFront() anygo.Option[T] {
+	if d.n == 0 {
+		return anygo.None[T]()
+	}
+	v := d.buf[d.head]
+	var zero T
+	d.buf[d.head] = zero
+	d.head = (d.head + 1) % len(d.buf)
+	d.n--
+	return anygo.Some(v)
+}
+
+// PopBack removes and returns the back item, or None if the deque is empty.
+func (d *Deque[T]) PopBack() anygo.Option[T] {
+	if d.n == 0 {
+		return anygo.None[T]()
+	}
+	i := (d.head + d.n - 1) % len(d.buf)
+	v := d.buf[i]
+	var zero T
+	d.buf[i] = zero
+	d.n--
+	return anygo.Some(v)
+}
+
+// PeekFront returns the front item without removing it, or None if the
+// deque is empty.
+func (d *Deque[T]) PeekFront() anygo.Option[T] {
+	if d.n == 0 {
+		return anygo.None[T]()
+	}
+	return anygo.Some(d.buf[d.head])
+}
+
+// PeekBack returns the back item without removing it, or None if the deque
+// is empty.
+func (d *Deque[T]) PeekBack() anygo.Option[T] {
+	if d.n == 0 {
+		return anygo.None[T]()
+	}
+	return anygo.Some(d.buf[(d.head+d.n-1)%len(d.buf)])
+}
+
+// Len returns the number of items.
+func (d *Deque[T]) Len() int {
+	return d.n
+}
+
+// grow makes room for one more item, doubling the buffer when it is full.
+func (d *Deque[T]) grow() {
+	if d.n < len(d.buf) {
+		return
+	}
+	buf := make([]T, max(4, 2*len(d.buf)))
+	for i := range d.n {
+		buf[i] = d.buf[(d.head+i)%len(d.buf)]
+	}
+	d.buf, d.head = buf, 0
+}
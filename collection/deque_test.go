@@ -0,0 +1,48 @@
+package collection_test
+
+import (
+	"testing"
+
+	"github.com/daxartio/anygo/collection"
+)
+
+func TestDeque(t *testing.T) {
+	d := collection.NewDeque(2, 3)
+	d.PushFront(1)
+	d.PushBack(4)
+	if f, _ := d.PeekFront().Unwrap(); f != 1 {
+		t.Fatalf("expected front 1, got %d", f)
+	}
+	if b, _ := d.PeekBack().Unwrap(); b != 4 || d.Len() != 4 {
+		t.Fatalf("expected back 4 with 4 items, got %d", b)
+	}
+	if v, _ := d.PopFront().Unwrap(); v != 1 {
+		t.Fatalf("expected 1, got %d", v)
+	}
+	if v, _ := d.PopBack().Unwrap(); v != 4 {
+		t.Fatalf("expected 4, got %d", v)
+	}
+	d.PopBack()
+	d.PopBack()
+	if d.PopFront().IsSome() || d.PopBack().IsSome() || d.PeekFront().IsSome() || d.PeekBack().IsSome() {
+		t.Fatal("expected None from an empty deque")
+	}
+}
+
+func TestDequeGrowth(t *testing.T) {
+	var d collection.Deque[int]
+	for i := range 50 {
+		d.PushFront(-i)
+		d.PushBack(i)
+	}
+	for i := 49; i >= 0; i-- {
+		if v, _ := d.PopFront().Unwrap(); v != -i {
+			t.Fatalf("expected %d at the front, got %d", -i, v)
+		}
+	}
+	for i := 49; i >= 0; i-- {
+		if v, _ := d.PopBack().Unwrap(); v != i {
+			t.Fatalf("expected %d at the back, got %d", i, v)
+		}
+	}
+}
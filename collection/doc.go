@@ -0,0 +1,4 @@
+// Package collection provides generic container types.
+//
+// Unless stated otherwise, the types are not safe for concurrent use.
+package collection
@@ -0,0 +1,163 @@
+package collection
+
+import "iter"
+
+// List is a typed doubly linked list, the generic counterpart of
+// container/list. The zero value is an empty list ready to use.
+type List[T any] struct {
+	root ListElement[T] // sentinel: root.next is the front, root.prev the back
+	len  int
+}
+
+// ListElement is an element of a List.
+type ListElement[T any] struct {
+	Value      T
+	next, prev *ListElement[T]
+	list       *List[T]
+}
+
+// Next returns the next element, or nil at the back of the list.
+func (e *ListElement[T]) Next() *ListElement[T] {
+	if n := e.next; e.list != nil && n != &e.list.root {
+		return n
+	}
+	return nil
+}
+
+// Prev returns the previous element, or nil at the front of the list.
+func (e *ListElement[T]) Prev() *ListElement[T] {
+	if p := e.prev; e.list != nil && p != &e.list.root {
+		return p
+	}
+	return nil
+}
+
+// NewList returns a List holding items in order.
+//
+// Example:
+//
+//	l := collection.NewList(1, 2, 3)
+//	l.MoveToFront(l.Back())
+//	for v := range l.All() {
+//		fmt.Println(v) // 3, 1, 2
+//	}
+func NewList[T any](items ...T) *List[T] {
+	l := &List[T]{}
+	for _, v := range items {
+		l.PushBack(v)
+	}
+	return l
+}
+
+func (l *List[T]) lazyInit() {
+	if l.root.next == nil {
+		l.root.next, l.root.prev = &l.root, &l.root
+	}
+}
+
+// Len returns the number of elements.
+func (l *List[T]) Len() int {
+	return l.len
+}
+
+// Front returns the first element, or nil if the list is empty.
+func (l *List[T]) Front() *ListElement[T] {
+	if l.len == 0 {
+		return nil
+	}
+	return l.root.next
+}
+
+// Back returns the last element, or nil if the list is empty.
+func (l *List[T]) Back() *ListElement[T] {
+	if l.len == 0 {
+		return nil
+	}
+	return l.root.prev
+}
+
+// PushFront inserts v at the front and returns its element.
+func (l *List[T]) PushFront(v T) *ListElement[T] {
+	l.lazyInit()
+	return l.insert(&ListElement[T]{Value: v}, &l.root)
+}
+
+// PushBack inserts v at the back and returns its element.
+func (l *List[T]) PushBack(v T) *ListElement[T] {
+	l.lazyInit()
+	return l.insert(&ListElement[T]{Value: v}, l.root.prev)
+}
+
+// InsertBefore inserts v just before mark and returns its element. mark must
+// be an element of l.
+func (l *List[T]) InsertBefore(v T, mark *ListElement[T]) *ListElement[T] {
+	if mark.list != l {
+		return nil
+	}
+	return l.insert(&ListElement[T]{Value: v}, mark.prev)
+}
+
+// InsertAfter inserts v just after mark and returns its element. mark must
+// be an element of l.
+func (l *List[T]) InsertAfter(v T, mark *ListElement[T]) *ListElement[T] {
+	if mark.list != l {
+		return nil
+	}
+	return l.insert(&ListElement[T]{Value: v}, mark)
+}
+
+// Remove removes e from l if it is an element of l and returns its value.
+func (l *List[T]) Remove(e *ListElement[T]) T {
+	if e.list == l {
+		l.unlink(e)
+	}
+	return e.Value
+}
+
+// MoveToFront moves e to the front of l. It does nothing if e is not an
+// element of l.
+func (l *List[T]) MoveToFront(e *ListElement[T]) {
+	if e.list != l || l.root.next == e {
+		return
+	}
+	l.unlink(e)
+	l.insert(e, &l.root)
+}
+
+// MoveToBack moves e to the back of l. It does nothing if e is not an
+// element of l.
+func (l *List[T]) MoveToBack(e *ListElement[T]) {
+	if e.list != l || l.root.prev == e {
+		return
+	}
+	l.unlink(e)
+	l.insert(e, l.root.prev)
+}
+
+// All returns an iterator over the values from front to back.
+func (l *List[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for e := l.Front(); e != nil; e = e.Next() {
+			if !yield(e.Value) {
+				return
+			}
+		}
+	}
+}
+
+// insert links e after at.
+func (l *List[T]) insert(e, at *ListElement[T]) *ListElement[T] {
+	e.prev, e.next = at, at.next
+	at.next.prev = e
+	at.next = e
+	e.list = l
+	l.len++
+	return e
+}
+
+func (l *List[T]) unlink(e *ListElement[T]) {
+	e.prev.next = e.next
+	e.next.prev = e.prev
+	e.next, e.prev, e.list = nil, nil, nil
+	l.len--
+}
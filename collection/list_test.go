@@ -0,0 +1,46 @@
+package collection_test
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/daxartio/anygo/collection"
+)
+
+func TestList(t *testing.T) {
+	l := collection.NewList(1, 2, 3)
+	l.MoveToFront(l.Back())
+	if got := slices.Collect(l.All()); !slices.Equal(got, []int{3, 1, 2}) {
+		t.Fatalf("expected [3 1 2], got %v", got)
+	}
+	l.MoveToBack(l.Front())
+	mid := l.Front().Next()
+	l.InsertBefore(10, mid)
+	l.InsertAfter(20, mid)
+	if got := slices.Collect(l.All()); !slices.Equal(got, []int{1, 10, 2, 20, 3}) {
+		t.Fatalf("expected [1 10 2 20 3], got %v", got)
+	}
+	if v := l.Remove(mid); v != 2 || l.Len() != 4 {
+		t.Fatalf("expected to remove 2, got %d with len %d", v, l.Len())
+	}
+	if l.Back().Prev().Value != 20 || l.Front().Prev() != nil || l.Back().Next() != nil {
+		t.Fatal("unexpected neighbours")
+	}
+}
+
+func TestListZeroValue(t *testing.T) {
+	var l collection.List[string]
+	if l.Front() != nil || l.Back() != nil {
+		t.Fatal("expected an empty list")
+	}
+	l.PushBack("b")
+	l.PushFront("a")
+	other := collection.NewList("x")
+	if other.InsertAfter("y", l.Front()) != nil {
+		t.Fatal("expected InsertAfter to ignore foreign elements")
+	}
+	other.Remove(l.Front())
+	if got := slices.Collect(l.All()); !slices.Equal(got, []string{"a", "b"}) {
+		t.Fatalf("expected [a b], got %v", got)
+	}
+}
@@ -0,0 +1,77 @@
+package collection_test
+
+import (
+	"encoding/json"
+	"slices"
+	"testing"
+
+	"github.com/daxartio/anygo/collection"
+)
+
+func TestOrderedMap(t *testing.T) {
+	m := collection.NewOrderedMap[string, int]()
+	m.Set("c", 1)
+	m.Set("a", 2)
+	m.Set("b", 3)
+	m.Set("a", 4)
+	if v, ok := m.Get("a"); !ok || v != 4 {
+		t.Fatalf("expected 4, got %d", v)
+	}
+	if got := slices.Collect(m.Keys()); !slices.Equal(got, []string{"c", "a", "b"}) {
+		t.Fatalf("expected insertion order, got %v", got)
+	}
+
+	if !m.Delete("a") || m.Delete("a") {
+		t.Fatal("unexpected Delete result")
+	}
+	if got := slices.Collect(m.Values()); !slices.Equal(got, []int{1, 3}) {
+		t.Fatalf("expected [1 3], got %v", got)
+	}
+	if m.Len() != 2 {
+		t.Fatalf("expected 2 entries, got %d", m.Len())
+	}
+}
+
+func TestOrderedMapZeroValue(t *testing.T) {
+	var m collection.OrderedMap[int, string]
+	if _, ok := m.Get(1); ok {
+		t.Fatal("expected empty map")
+	}
+	m.Set(1, "x")
+	for k, v := range m.All() {
+		if k != 1 || v != "x" {
+			t.Fatalf("unexpected entry %d=%s", k, v)
+		}
+	}
+}
+
+func TestOrderedMapJSON(t *testing.T) {
+	m := collection.NewOrderedMap[string, int]()
+	m.Set("z", 1)
+	m.Set("a", 2)
+	b, err := json.Marshal(m)
+	if err != nil || string(b) != `{"z":1,"a":2}` {
+		t.Fatalf("unexpected JSON %s (%v)", b, err)
+	}
+
+	var out collection.OrderedMap[int, string]
+	if err := json.Unmarshal([]byte(`{"3":"c","1":"a"}`), &out); err != nil {
+		t.Fatal(err)
+	}
+	if got := slices.Collect(out.Keys()); !slices.Equal(got, []int{3, 1}) {
+		t.Fatalf("expected key order [3 1], got %v", got)
+	}
+}
+
+func TestOrderedMapJSONValueField(t *testing.T) {
+	m := collection.NewOrderedMap[string, int]()
+	m.Set("z", 1)
+	m.Set("a", 2)
+	v := struct {
+		M collection.OrderedMap[string, int] `json:"m"`
+	}{M: *m}
+	b, err := json.Marshal(v)
+	if err != nil || string(b) != `{"m":{"z":1,"a":2}}` {
+		t.Fatalf("expected ordered object for a value field, got %s (%v)", b, err)
+	}
+}
@@ -0,0 +1,97 @@
+package collection
+
+import (
+	"iter"
+
+	"github.com/daxartio/anygo"
+)
+
+// PersistentList is an immutable singly linked list. Prepending returns a new
+// list that shares the existing nodes, so lists can be passed between
+// goroutines and extended without copying or locking. The zero value is an
+// empty list.
+type PersistentList[T any] struct {
+	head *persistentNode[T]
+	len  int
+}
+
+type persistentNode[T any] struct {
+	value T
+	next  *persistentNode[T]
+}
+
+// PersistentListOf returns a PersistentList holding items in order.
+//
+// Example:
+//
+//	base := collection.PersistentListOf(2, 3)
+//	a := base.Prepend(1) // [1 2 3]
+//	b := base.Prepend(0) // [0 2 3], sharing [2 3] with a
+func PersistentListOf[T any](items ...T) PersistentList[T] {
+	var l PersistentList[T]
+	for i := len(items) - 1; i >= 0; i-- {
+		l = l.Prepend(items[i])
+	}
+	return l
+}
+
+// Prepend returns a new list with v in front of l. l is left unchanged.
+func (l PersistentList[T]) Prepend(v T) PersistentList[T] {
+	return PersistentList[T]{head: &persistentNode[T]{value: v, next: l.head}, len: l.len + 1}
+}
+
+// Head returns the first value, or None if the list is empty.
+func (l PersistentList[T]) Head() anygo.Option[T] {
+	if l.head == nil {
+		return anygo.None[T]()
+	}
+	return anygo.Some(l.head.value)
+}
+
+// Tail returns the list without its first value. The tail of an empty list
+// is empty.
+func (l PersistentList[T]) Tail() PersistentList[T] {
+	if l.head == nil {
+		return l
+	}
+	return PersistentList[T]{head: l.head.next, len: l.len - 1}
+}
+
+// Len returns the number of values.
+func (l PersistentList[T]) Len() int {
+	return l.len
+}
+
+// IsEmpty reports whether the list has no values.
+func (l PersistentList[T]) IsEmpty() bool {
+	return l.head == nil
+}
+
+// Reverse returns a new list with the values in reverse order.
+func (l PersistentList[T]) Reverse() PersistentList[T] {
+	var out PersistentList[T]
+	for v := range l.All() {
+		out = out.Prepend(v)
+	}
+	return out
+}
+
+// All returns an iterator over the values from front to back.
+func (l PersistentList[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for n := l.head; n != nil; n = n.next {
+			if !yield(n.value) {
+				return
+			}
+		}
+	}
+}
+
+// ToSlice returns the values in order.
+func (l PersistentList[T]) ToSlice() []T {
+	out := make([]T, 0, l.len)
+	for v := range l.All() {
+		out = append(out, v)
+	}
+	return out
+}
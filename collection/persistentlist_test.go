@@ -0,0 +1,36 @@
+package collection_test
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/daxartio/anygo/collection"
+)
+
+func TestPersistentList(t *testing.T) {
+	base := collection.PersistentListOf(2, 3)
+	a := base.Prepend(1)
+	b := base.Prepend(0)
+	if !slices.Equal(a.ToSlice(), []int{1, 2, 3}) || !slices.Equal(b.ToSlice(), []int{0, 2, 3}) {
+		t.Fatalf("unexpected lists %v %v", a.ToSlice(), b.ToSlice())
+	}
+	if !slices.Equal(base.ToSlice(), []int{2, 3}) || base.Len() != 2 {
+		t.Fatal("expected base to be unchanged")
+	}
+	if v, ok := a.Head().Unwrap(); !ok || v != 1 {
+		t.Fatalf("expected head 1, got %v", v)
+	}
+	if got := a.Tail().ToSlice(); !slices.Equal(got, []int{2, 3}) {
+		t.Fatalf("expected tail [2 3], got %v", got)
+	}
+	if got := a.Reverse().ToSlice(); !slices.Equal(got, []int{3, 2, 1}) {
+		t.Fatalf("expected [3 2 1], got %v", got)
+	}
+}
+
+func TestPersistentListEmpty(t *testing.T) {
+	var l collection.PersistentList[int]
+	if !l.IsEmpty() || l.Head().IsSome() || !l.Tail().IsEmpty() || len(l.ToSlice()) != 0 {
+		t.Fatal("expected an empty list")
+	}
+}
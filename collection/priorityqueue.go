@@ -0,0 +1,115 @@
+package collection
+
+import (
+	"container/heap"
+
+	"github.com/daxartio/anygo"
+)
+
+// PriorityQueue is a heap-ordered queue: Pop returns the item that sorts
+// first according to its less function.
+type PriorityQueue[T any] struct {
+	h        queueHeap[T]
+	capacity int // zero means unbounded
+}
+
+// queueHeap adapts a slice to container/heap.
+type queueHeap[T any] struct {
+	items []T
+	less  func(a, b T) bool
+}
+
+func (h *queueHeap[T]) Len() int           { return len(h.items) }
+func (h *queueHeap[T]) Less(i, j int) bool { return h.less(h.items[i], h.items[j]) }
+func (h *queueHeap[T]) Swap(i, j int)      { h.items[i], h.items[j] = h.items[j], h.items[i] }
+func (h *queueHeap[T]) Push(x any)         { h.items = append(h.items, x.(T)) }
+
+func (h *queueHeap[T]) Pop() any {
+	n := len(h.items) - 1
+	v := h.items[n]
+	var zero T
+	h.items[n] = zero
+	h.items = h.items[:n]
+	return v
+}
+
+// NewPriorityQueue returns an empty unbounded PriorityQueue ordered by less.
+//
+// Example:
+//
+//	q := collection.NewPriorityQueue(func(a, b Job) bool { return a.Due.Before(b.Due) })
+//	q.Push(job)
+//	next, ok := q.Pop().Unwrap()
+func NewPriorityQueue[T any](less func(a, b T) bool) *PriorityQueue[T] {
+	return &PriorityQueue[T]{h: queueHeap[T]{less: less}}
+}
+
+// NewBoundedPriorityQueue returns an empty PriorityQueue that holds at most
+// capacity items. When it is full, Push keeps the capacity items that sort
+// first and drops the one that sorts last, which makes it suitable for
+// top-N selection. It panics if capacity is less than 1.
+//
+// Example:
+//
+//	top := collection.NewBoundedPriorityQueue(func(a, b Score) bool { return a.Value > b.Value }, 10)
+//	for _, s := range scores {
+//		top.Push(s)
+//	}
+func NewBoundedPriorityQueue[T any](less func(a, b T) bool, capacity int) *PriorityQueue[T] {
+	if capacity < 1 {
+		panic("collection: PriorityQueue capacity must be at least 1")
+	}
+	q := NewPriorityQueue(less)
+	q.capacity = capacity
+	return q
+}
+
+// Push adds v to the queue and reports whether it was kept. It returns false
+// only for a full bounded queue when v sorts after every queued item.
+func (q *PriorityQueue[T]) Push(v T) bool {
+	if q.capacity > 0 && q.h.Len() >= q.capacity {
+		worst := q.worst()
+		if !q.h.less(v, q.h.items[worst]) {
+			return false
+		}
+		heap.Remove(&q.h, worst)
+	}
+	heap.Push(&q.h, v)
+	return true
+}
+
+// Pop removes and returns the item that sorts first, or None if the queue is
+// empty.
+func (q *PriorityQueue[T]) Pop() anygo.Option[T] {
+	if q.h.Len() == 0 {
+		return anygo.None[T]()
+	}
+	return anygo.Some(heap.Pop(&q.h).(T))
+}
+
+// Peek returns the item that sorts first without removing it, or None if
+// the queue is empty.
+func (q *PriorityQueue[T]) Peek() anygo.Option[T] {
+	if q.h.Len() == 0 {
+		return anygo.None[T]()
+	}
+	return anygo.Some(q.h.items[0])
+}
+
+// Len returns the number of queued items.
+func (q *PriorityQueue[T]) Len() int {
+	return q.h.Len()
+}
+
+// worst returns the index of the item that sorts last. It is always a leaf,
+// so only the second half of the heap is scanned.
+func (q *PriorityQueue[T]) worst() int {
+	n := q.h.Len()
+	worst := n / 2
+	for i := worst + 1; i < n; i++ {
+		if q.h.less(q.h.items[worst], q.h.items[i]) {
+			worst = i
+		}
+	}
+	return worst
+}
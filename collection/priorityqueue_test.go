@@ -0,0 +1,48 @@
+package collection_test
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/daxartio/anygo/collection"
+)
+
+func drain(q *collection.PriorityQueue[int]) []int {
+	var out []int
+	for {
+		v, ok := q.Pop().Unwrap()
+		if !ok {
+			return out
+		}
+		out = append(out, v)
+	}
+}
+
+func TestPriorityQueue(t *testing.T) {
+	q := collection.NewPriorityQueue(func(a, b int) bool { return a < b })
+	for _, v := range []int{5, 1, 4, 2, 3} {
+		q.Push(v)
+	}
+	if v, ok := q.Peek().Unwrap(); !ok || v != 1 || q.Len() != 5 {
+		t.Fatalf("expected to peek 1 with 5 items, got %v", v)
+	}
+	if got := drain(q); !slices.Equal(got, []int{1, 2, 3, 4, 5}) {
+		t.Fatalf("unexpected order %v", got)
+	}
+	if q.Pop().IsSome() || q.Peek().IsSome() {
+		t.Fatal("expected None from an empty queue")
+	}
+}
+
+func TestBoundedPriorityQueue(t *testing.T) {
+	q := collection.NewBoundedPriorityQueue(func(a, b int) bool { return a > b }, 3)
+	for _, v := range []int{5, 1, 9, 7, 3, 8} {
+		q.Push(v)
+	}
+	if q.Push(0) {
+		t.Fatal("expected a full queue to reject an item that sorts last")
+	}
+	if got := drain(q); !slices.Equal(got, []int{9, 8, 7}) {
+		t.Fatalf("expected top 3, got %v", got)
+	}
+}
@@ -0,0 +1,58 @@
+package collection
+
+import "github.com/daxartio/anygo"
+
+// Queue is a first-in, first-out collection backed by a slice. The zero
+// value is an empty queue ready to use.
+type Queue[T any] struct {
+	items []T
+	head  int // index of the front item
+}
+
+// NewQueue returns a Queue holding items, with the first item at the front.
+//
+// Example:
+//
+//	q := collection.NewQueue[string]()
+//	q.Push("a")
+//	q.Push("b")
+//	v, _ := q.Pop().Unwrap() // "a"
+func NewQueue[T any](items ...T) *Queue[T] {
+	return &Queue[T]{items: append([]T(nil), items...)}
+}
+
+// Push adds v at the back of the queue.
+func (q *Queue[T]) Push(v T) {
+	q.items = append(q.items, v)
+}
+
+// Pop removes and returns the front item, or None if the queue is empty.
+func (q *Queue[T]) Pop() anygo.Option[T] {
+	if q.Len() == 0 {
+		return anygo.None[T]()
+	}
+	v := q.items[q.head]
+	var zero T
+	q.items[q.head] = zero
+	q.head++
+	// Reclaim the consumed prefix once it dominates the slice.
+	if q.head*2 >= len(q.items) {
+		q.items = append(q.items[:0], q.items[q.head:]...)
+		q.head = 0
+	}
+	return anygo.Some(v)
+}
+
+// Peek returns the front item without removing it, or None if the queue is
+// empty.
+func (q *Queue[T]) Peek() anygo.Option[T] {
+	if q.Len() == 0 {
+		return anygo.None[T]()
+	}
+	return anygo.Some(q.items[q.head])
+}
+
+// Len returns the number of items.
+func (q *Queue[T]) Len() int {
+	return len(q.items) - q.head
+}
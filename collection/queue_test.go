@@ -0,0 +1,46 @@
+package collection_test
+
+import (
+	"testing"
+
+	"github.com/daxartio/anygo/collection"
+)
+
+func TestQueue(t *testing.T) {
+	q := collection.NewQueue(1, 2)
+	q.Push(3)
+	if v, ok := q.Peek().Unwrap(); !ok || v != 1 || q.Len() != 3 {
+		t.Fatalf("expected front 1 with 3 items, got %v", v)
+	}
+	for _, want := range []int{1, 2, 3} {
+		if v, ok := q.Pop().Unwrap(); !ok || v != want {
+			t.Fatalf("expected %d, got %v", want, v)
+		}
+	}
+	if q.Pop().IsSome() || q.Peek().IsSome() {
+		t.Fatal("expected None from an empty queue")
+	}
+}
+
+func TestQueueInterleaved(t *testing.T) {
+	var q collection.Queue[int]
+	next := 0
+	for i := range 100 {
+		q.Push(i)
+		if i%3 == 0 {
+			if v, _ := q.Pop().Unwrap(); v != next {
+				t.Fatalf("expected %d, got %d", next, v)
+			}
+			next++
+		}
+	}
+	if q.Len() != 100-next {
+		t.Fatalf("expected %d items, got %d", 100-next, q.Len())
+	}
+	for q.Len() > 0 {
+		if v, _ := q.Pop().Unwrap(); v != next {
+			t.Fatalf("expected %d, got %d", next, v)
+		}
+		next++
+	}
+}
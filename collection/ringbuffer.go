@@ -0,0 +1,87 @@
+package collection
+
+import "github.com/daxartio/anygo"
+
+// OverflowPolicy decides what RingBuffer.Push does when the buffer is full.
+type OverflowPolicy int
+
+const (
+	// OverwriteOldest drops the oldest item to make room for the new one.
+	OverwriteOldest OverflowPolicy = iota
+	// RejectWhenFull keeps the buffer unchanged and rejects the new item.
+	RejectWhenFull
+)
+
+// RingBuffer is a fixed-capacity circular buffer.
+type RingBuffer[T any] struct {
+	buf    []T
+	head   int // index of the oldest item
+	n      int
+	policy OverflowPolicy
+}
+
+// NewRingBuffer returns an empty RingBuffer holding at most capacity items.
+// It panics if capacity is less than 1.
+//
+// Example:
+//
+//	lines := collection.NewRingBuffer[string](100, collection.OverwriteOldest)
+//	for scanner.Scan() {
+//		lines.Push(scanner.Text())
+//	}
+//	last := lines.ToSlice() // the last 100 lines, oldest first
+func NewRingBuffer[T any](capacity int, policy OverflowPolicy) *RingBuffer[T] {
+	if capacity < 1 {
+		panic("collection: RingBuffer capacity must be at least 1")
+	}
+	return &RingBuffer[T]{buf: make([]T, capacity), policy: policy}
+}
+
+// Push adds v as the newest item and reports whether it was stored. A full
+// buffer either drops its oldest item or rejects v, depending on its
+// OverflowPolicy.
+func (r *RingBuffer[T]) Push(v T) bool {
+	if r.n == len(r.buf) {
+		if r.policy == RejectWhenFull {
+			return false
+		}
+		r.buf[r.head] = v
+		r.head = (r.head + 1) % len(r.buf)
+		return true
+	}
+	r.buf[(r.head+r.n)%len(r.buf)] = v
+	r.n++
+	return true
+}
+
+// Pop removes and returns the oldest item, or None if the buffer is empty.
+func (r *RingBuffer[T]) Pop() anygo.Option[T] {
+	if r.n == 0 {
+		return anygo.None[T]()
+	}
+	v := r.buf[r.head]
+	var zero T
+	r.buf[r.head] = zero
+	r.head = (r.head + 1) % len(r.buf)
+	r.n--
+	return anygo.Some(v)
+}
+
+// Len returns the number of items.
+func (r *RingBuffer[T]) Len() int {
+	return r.n
+}
+
+// Cap returns the capacity.
+func (r *RingBuffer[T]) Cap() int {
+	return len(r.buf)
+}
+
+// ToSlice returns a copy of the items, oldest first.
+func (r *RingBuffer[T]) ToSlice() []T {
+	out := make([]T, r.n)
+	for i := range r.n {
+		out[i] = r.buf[(r.head+i)%len(r.buf)]
+	}
+	return out
+}
@@ -0,0 +1,44 @@
+package collection_test
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/daxartio/anygo/collection"
+)
+
+func TestRingBufferOverwrite(t *testing.T) {
+	r := collection.NewRingBuffer[int](3, collection.OverwriteOldest)
+	for i := 1; i <= 5; i++ {
+		if !r.Push(i) {
+			t.Fatalf("expected Push(%d) to succeed", i)
+		}
+	}
+	if got := r.ToSlice(); !slices.Equal(got, []int{3, 4, 5}) || r.Len() != 3 || r.Cap() != 3 {
+		t.Fatalf("expected the last 3 items, got %v", got)
+	}
+	if v, _ := r.Pop().Unwrap(); v != 3 {
+		t.Fatalf("expected oldest 3, got %d", v)
+	}
+	r.Push(6)
+	if got := r.ToSlice(); !slices.Equal(got, []int{4, 5, 6}) {
+		t.Fatalf("unexpected items %v", got)
+	}
+}
+
+func TestRingBufferReject(t *testing.T) {
+	r := collection.NewRingBuffer[int](2, collection.RejectWhenFull)
+	r.Push(1)
+	r.Push(2)
+	if r.Push(3) {
+		t.Fatal("expected a full buffer to reject")
+	}
+	if got := r.ToSlice(); !slices.Equal(got, []int{1, 2}) {
+		t.Fatalf("expected buffer unchanged, got %v", got)
+	}
+	r.Pop()
+	r.Pop()
+	if r.Pop().IsSome() || len(r.ToSlice()) != 0 {
+		t.Fatal("expected an empty buffer")
+	}
+}
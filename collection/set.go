@@ -0,0 +1,102 @@
+package collection
+
+import "encoding/json"
+
+// Set is an unordered collection of unique values. Create one with NewSet or
+// make; the nil Set is empty and read-only.
+type Set[T comparable] map[T]struct{}
+
+// NewSet returns a Set containing items.
+//
+// Example:
+//
+//	s := collection.NewSet("a", "b")
+//	fmt.Println(s.Contains("a")) // true
+func NewSet[T comparable](items ...T) Set[T] {
+	s := make(Set[T], len(items))
+	s.Add(items...)
+	return s
+}
+
+// Add inserts items into the set.
+func (s Set[T]) Add(items ...T) {
+	for _, v := range items {
+		s[v] = struct{}{}
+	}
+}
+
+// Remove deletes v from the set.
+func (s Set[T]) Remove(v T) {
+	delete(s, v)
+}
+
+// Contains reports whether v is in the set.
+func (s Set[T]) Contains(v T) bool {
+	_, ok := s[v]
+	return ok
+}
+
+// Len returns the number of values in the set.
+func (s Set[T]) Len() int {
+	return len(s)
+}
+
+// Union returns a new set with the values of s and other.
+func (s Set[T]) Union(other Set[T]) Set[T] {
+	out := make(Set[T], len(s)+len(other))
+	for v := range s {
+		out[v] = struct{}{}
+	}
+	for v := range other {
+		out[v] = struct{}{}
+	}
+	return out
+}
+
+// Intersect returns a new set with the values present in both s and other.
+func (s Set[T]) Intersect(other Set[T]) Set[T] {
+	out := make(Set[T])
+	for v := range s {
+		if other.Contains(v) {
+			out[v] = struct{}{}
+		}
+	}
+	return out
+}
+
+// Difference returns a new set with the values of s that are not in other.
+func (s Set[T]) Difference(other Set[T]) Set[T] {
+	out := make(Set[T])
+	for v := range s {
+		if !other.Contains(v) {
+			out[v] = struct{}{}
+		}
+	}
+	return out
+}
+
+// ToSlice returns the values of the set in unspecified order.
+func (s Set[T]) ToSlice() []T {
+	out := make([]T, 0, len(s))
+	for v := range s {
+		out = append(out, v)
+	}
+	return out
+}
+
+// MarshalJSON implements json.Marshaler. The set is encoded as an array in
+// unspecified order.
+func (s Set[T]) MarshalJSON() ([]byte, error) {
+	return json.Marshal(s.ToSlice())
+}
+
+// UnmarshalJSON implements json.Unmarshaler. It decodes an array, dropping
+// duplicates.
+func (s *Set[T]) UnmarshalJSON(data []byte) error {
+	var items []T
+	if err := json.Unmarshal(data, &items); err != nil {
+		return err
+	}
+	*s = NewSet(items...)
+	return nil
+}
@@ -0,0 +1,55 @@
+package collection_test
+
+import (
+	"encoding/json"
+	"slices"
+	"testing"
+
+	"github.com/daxartio/anygo/collection"
+)
+
+func sorted[T int | string](items []T) []T {
+	slices.Sort(items)
+	return items
+}
+
+func TestSet(t *testing.T) {
+	s := collection.NewSet(1, 2, 2)
+	if s.Len() != 2 || !s.Contains(1) || s.Contains(3) {
+		t.Fatalf("unexpected set %v", s)
+	}
+	s.Add(3)
+	s.Remove(1)
+	if got := sorted(s.ToSlice()); !slices.Equal(got, []int{2, 3}) {
+		t.Fatalf("expected [2 3], got %v", got)
+	}
+}
+
+func TestSetOperations(t *testing.T) {
+	a := collection.NewSet(1, 2, 3)
+	b := collection.NewSet(2, 3, 4)
+	if got := sorted(a.Union(b).ToSlice()); !slices.Equal(got, []int{1, 2, 3, 4}) {
+		t.Fatalf("unexpected union %v", got)
+	}
+	if got := sorted(a.Intersect(b).ToSlice()); !slices.Equal(got, []int{2, 3}) {
+		t.Fatalf("unexpected intersection %v", got)
+	}
+	if got := sorted(a.Difference(b).ToSlice()); !slices.Equal(got, []int{1}) {
+		t.Fatalf("unexpected difference %v", got)
+	}
+}
+
+func TestSetJSON(t *testing.T) {
+	b, err := json.Marshal(collection.NewSet("a"))
+	if err != nil || string(b) != `["a"]` {
+		t.Fatalf("unexpected JSON %s (%v)", b, err)
+	}
+
+	var s collection.Set[string]
+	if err := json.Unmarshal([]byte(`["x","y","x"]`), &s); err != nil {
+		t.Fatal(err)
+	}
+	if got := sorted(s.ToSlice()); !slices.Equal(got, []string{"x", "y"}) {
+		t.Fatalf("expected [x y], got %v", got)
+	}
+}
@@ -0,0 +1,52 @@
+package collection
+
+import "github.com/daxartio/anygo"
+
+// Stack is a last-in, first-out collection backed by a slice. The zero value
+// is an empty stack ready to use.
+type Stack[T any] struct {
+	items []T
+}
+
+// NewStack returns a Stack holding items, with the last item on top.
+//
+// Example:
+//
+//	s := collection.NewStack(1, 2)
+//	s.Push(3)
+//	v, _ := s.Pop().Unwrap() // 3
+func NewStack[T any](items ...T) *Stack[T] {
+	return &Stack[T]{items: append([]T(nil), items...)}
+}
+
+// Push adds v on top of the stack.
+func (s *Stack[T]) Push(v T) {
+	s.items = append(s.items, v)
+}
+
+// Pop removes and returns the top item, or None if the stack is empty.
+func (s *Stack[T]) Pop() anygo.Option[T] {
+	n := len(s.items) - 1
+	if n < 0 {
+		return anygo.None[T]()
+	}
+	v := s.items[n]
+	var zero T
+	s.items[n] = zero
+	s.items = s.items[:n]
+	return anygo.Some(v)
+}
+
+// Peek returns the top item without removing it, or None if the stack is
+// empty.
+func (s *Stack[T]) Peek() anygo.Option[T] {
+	if len(s.items) == 0 {
+		return anygo.None[T]()
+	}
+	return anygo.Some(s.items[len(s.items)-1])
+}
+
+// Len returns the number of items.
+func (s *Stack[T]) Len() int {
+	return len(s.items)
+}
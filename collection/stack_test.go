@@ -0,0 +1,31 @@
+package collection_test
+
+import (
+	"testing"
+
+	"github.com/daxartio/anygo/collection"
+)
+
+func TestStack(t *testing.T) {
+	s := collection.NewStack(1, 2)
+	s.Push(3)
+	if v, ok := s.Peek().Unwrap(); !ok || v != 3 || s.Len() != 3 {
+		t.Fatalf("expected top 3 with 3 items, got %v", v)
+	}
+	for _, want := range []int{3, 2, 1} {
+		if v, ok := s.Pop().Unwrap(); !ok || v != want {
+			t.Fatalf("expected %d, got %v", want, v)
+		}
+	}
+	if s.Pop().IsSome() || s.Peek().IsSome() {
+		t.Fatal("expected None from an empty stack")
+	}
+}
+
+func TestStackZeroValue(t *testing.T) {
+	var s collection.Stack[string]
+	s.Push("a")
+	if v, _ := s.Pop().Unwrap(); v != "a" {
+		t.Fatalf("expected a, got '%s'", v)
+	}
+}
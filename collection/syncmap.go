@@ -0,0 +1,183 @@
+package collection
+
+import (
+	"hash/maphash"
+	"iter"
+	"sync"
+)
+
+// SyncMap is a typed wrapper around sync.Map. It is safe for concurrent use
+// and the zero value is an empty map ready to use. Like sync.Map, it must
+// not be copied after first use.
+type SyncMap[K comparable, V any] struct {
+	m sync.Map
+}
+
+// Load returns the value for k and whether it was present.
+func (m *SyncMap[K, V]) Load(k K) (V, bool) {
+	v, ok := m.m.Load(k)
+	return typed[V](v), ok
+}
+
+// Store sets the value for k.
+func (m *SyncMap[K, V]) Store(k K, v V) {
+	m.m.Store(k, v)
+}
+
+// LoadOrStore returns the existing value for k if present. Otherwise it
+// stores and returns v. The bool is true if the value was loaded.
+//
+// Example:
+//
+//	var sessions collection.SyncMap[string, *Session]
+//	s, loaded := sessions.LoadOrStore(id, newSession(id))
+func (m *SyncMap[K, V]) LoadOrStore(k K, v V) (V, bool) {
+	actual, loaded := m.m.LoadOrStore(k, v)
+	return typed[V](actual), loaded
+}
+
+// LoadAndDelete deletes k and returns its previous value, if any.
+func (m *SyncMap[K, V]) LoadAndDelete(k K) (V, bool) {
+	v, ok := m.m.LoadAndDelete(k)
+	return typed[V](v), ok
+}
+
+// Delete removes k.
+func (m *SyncMap[K, V]) Delete(k K) {
+	m.m.Delete(k)
+}
+
+// Range calls f for each entry until f returns false, with the same
+// consistency guarantees as sync.Map.Range.
+func (m *SyncMap[K, V]) Range(f func(K, V) bool) {
+	m.m.Range(func(k, v any) bool { return f(typed[K](k), typed[V](v)) })
+}
+
+// typed converts a value read from sync.Map back to T. A nil interface,
+// stored for an interface-typed T, becomes the zero value.
+func typed[T any](v any) T {
+	t, _ := v.(T)
+	return t
+}
+
+// All returns an iterator over the entries, in no particular order.
+func (m *SyncMap[K, V]) All() iter.Seq2[K, V] {
+	return m.Range
+}
+
+// ConcurrentMap is a map split into independently locked shards, which
+// reduces lock contention under write-heavy workloads. It is safe for
+// concurrent use.
+type ConcurrentMap[K comparable, V any] struct {
+	seed   maphash.Seed
+	shards []mapShard[K, V]
+}
+
+type mapShard[K comparable, V any] struct {
+	mu sync.RWMutex
+	m  map[K]V
+}
+
+// NewConcurrentMap returns an empty ConcurrentMap with the given number of
+// shards. Values below 1 mean a single shard.
+//
+// Example:
+//
+//	hits := collection.NewConcurrentMap[string, int](32)
+//	hits.Compute(path, func(n int, _ bool) (int, bool) { return n + 1, true })
+func NewConcurrentMap[K comparable, V any](shards int) *ConcurrentMap[K, V] {
+	m := &ConcurrentMap[K, V]{
+		seed:   maphash.MakeSeed(),
+		shards: make([]mapShard[K, V], max(1, shards)),
+	}
+	for i := range m.shards {
+		m.shards[i].m = make(map[K]V)
+	}
+	return m
+}
+
+func (m *ConcurrentMap[K, V]) shard(k K) *mapShard[K, V] {
+	return &m.shards[maphash.Comparable(m.seed, k)%uint64(len(m.shards))]
+}
+
+// Get returns the value for k and whether it was present.
+func (m *ConcurrentMap[K, V]) Get(k K) (V, bool) {
+	s := m.shard(k)
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	v, ok := s.m[k]
+	return v, ok
+}
+
+// Set stores v under k.
+func (m *ConcurrentMap[K, V]) Set(k K, v V) {
+	s := m.shard(k)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.m[k] = v
+}
+
+// Delete removes k and reports whether it was present.
+func (m *ConcurrentMap[K, V]) Delete(k K) bool {
+	s := m.shard(k)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	_, ok := s.m[k]
+	delete(s.m, k)
+	return ok
+}
+
+// Compute atomically updates the entry for k. f receives the current value
+// and whether it was present, and returns the new value and whether to keep
+// it; returning false deletes the entry. Compute returns what f returned.
+// f runs with the shard locked and must not access the map.
+func (m *ConcurrentMap[K, V]) Compute(k K, f func(old V, ok bool) (V, bool)) (V, bool) {
+	s := m.shard(k)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	old, ok := s.m[k]
+	v, keep := f(old, ok)
+	if keep {
+		s.m[k] = v
+	} else {
+		delete(s.m, k)
+	}
+	return v, keep
+}
+
+// Len returns the number of entries. Under concurrent writes the result is
+// only approximate, since shards are counted one at a time.
+func (m *ConcurrentMap[K, V]) Len() int {
+	n := 0
+	for i := range m.shards {
+		s := &m.shards[i]
+		s.mu.RLock()
+		n += len(s.m)
+		s.mu.RUnlock()
+	}
+	return n
+}
+
+// All returns an iterator over the entries, in no particular order. Each
+// shard is read-locked while its entries are yielded, so the loop body must
+// not write to the map.
+func (m *ConcurrentMap[K, V]) All() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for i := range m.shards {
+			if !m.shards[i].each(yield) {
+				return
+			}
+		}
+	}
+}
+
+func (s *mapShard[K, V]) each(yield func(K, V) bool) bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	for k, v := range s.m {
+		if !yield(k, v) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,86 @@
+package collection_test
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/daxartio/anygo/collection"
+)
+
+func TestSyncMap(t *testing.T) {
+	var m collection.SyncMap[string, int]
+	if _, ok := m.Load("a"); ok {
+		t.Fatal("expected missing key")
+	}
+	m.Store("a", 1)
+	if v, loaded := m.LoadOrStore("a", 2); !loaded || v != 1 {
+		t.Fatalf("expected to load 1, got %d", v)
+	}
+	if v, loaded := m.LoadOrStore("b", 2); loaded || v != 2 {
+		t.Fatalf("expected to store 2, got %d", v)
+	}
+	sum := 0
+	for _, v := range m.All() {
+		sum += v
+	}
+	if sum != 3 {
+		t.Fatalf("expected sum 3, got %d", sum)
+	}
+	if v, ok := m.LoadAndDelete("a"); !ok || v != 1 {
+		t.Fatal("expected LoadAndDelete to return 1")
+	}
+	m.Delete("b")
+	if _, ok := m.LoadAndDelete("b"); ok {
+		t.Fatal("expected empty map")
+	}
+}
+
+func TestSyncMapNilInterface(t *testing.T) {
+	var m collection.SyncMap[string, error]
+	m.Store("a", nil)
+	if err, ok := m.Load("a"); !ok || err != nil {
+		t.Fatal("expected a stored nil error")
+	}
+}
+
+func TestConcurrentMap(t *testing.T) {
+	m := collection.NewConcurrentMap[int, string](4)
+	m.Set(1, "a")
+	if v, ok := m.Get(1); !ok || v != "a" {
+		t.Fatalf("expected a, got '%s'", v)
+	}
+	if !m.Delete(1) || m.Delete(1) || m.Len() != 0 {
+		t.Fatal("expected Delete to report presence once")
+	}
+}
+
+func TestConcurrentMapCompute(t *testing.T) {
+	m := collection.NewConcurrentMap[string, int](8)
+	var wg sync.WaitGroup
+	for range 100 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			m.Compute("n", func(n int, _ bool) (int, bool) { return n + 1, true })
+		}()
+	}
+	wg.Wait()
+	if v, _ := m.Get("n"); v != 100 {
+		t.Fatalf("expected 100, got %d", v)
+	}
+	m.Compute("n", func(int, bool) (int, bool) { return 0, false })
+	if _, ok := m.Get("n"); ok {
+		t.Fatal("expected Compute to delete the entry")
+	}
+
+	for i := range 10 {
+		m.Set(string(rune('a'+i)), i)
+	}
+	count := 0
+	for range m.All() {
+		count++
+	}
+	if count != 10 || m.Len() != 10 {
+		t.Fatalf("expected 10 entries, got %d", count)
+	}
+}
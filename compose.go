@@ -0,0 +1,300 @@
+package anygo
+
+// Pipe2 composes two functions left to right: the returned function calls
+// f1, then f2 with its result.
+//
+// Example:
+//
+//	slug := anygo.Pipe2(strings.TrimSpace, strings.ToLower)
+//	fmt.Println(slug("  Hello ")) // hello
+func Pipe2[A, B, C any](f1 func(A) B, f2 func(B) C) func(A) C {
+	return func(a A) C {
+		return f2(f1(a))
+	}
+}
+
+// Pipe3 is like Pipe2 for three functions.
+func Pipe3[A, B, C, D any](f1 func(A) B, f2 func(B) C, f3 func(C) D) func(A) D {
+	return func(a A) D {
+		return f3(f2(f1(a)))
+	}
+}
+
+// Pipe4 is like Pipe2 for four functions.
+func Pipe4[A, B, C, D, E any](f1 func(A) B, f2 func(B) C, f3 func(C) D, f4 func(D) E) func(A) E {
+	return func(a A) E {
+		return f4(f3(f2(f1(a))))
+	}
+}
+
+// Pipe5 is like Pipe2 for five functions.
+func Pipe5[A, B, C, D, E, F any](f1 func(A) B, f2 func(B) C, f3 func(C) D, f4 func(D) E, f5 func(E) F) func(A) F {
+	return func(a A) F {
+		return f5(f4(f3(f2(f1(a)))))
+	}
+}
+
+// Pipe6 is like Pipe2 for six functions.
+func Pipe6[A, B, C, D, E, F, G any](f1 func(A) B, f2 func(B) C, f3 func(C) D, f4 func(D) E, f5 func(E) F, f6 func(F) G) func(A) G {
+	return func(a A) G {
+		return f6(f5(f4(f3(f2(f1(a))))))
+	}
+}
+
+// Pipe7 is like Pipe2 for seven functions.
+func Pipe7[A, B, C, D, E, F, G, H any](f1 func(A) B, f2 func(B) C, f3 func(C) D, f4 func(D) E, f5 func(E) F, f6 func(F) G, f7 func(G) H) func(A) H {
+	return func(a A) H {
+		return f7(f6(f5(f4(f3(f2(f1(a)))))))
+	}
+}
+
+// Pipe8 is like Pipe2 for eight functions.
+func Pipe8[A, B, C, D, E, F, G, H, I any](f1 func(A) B, f2 func(B) C, f3 func(C) D, f4 func(D) E, f5 func(E) F, f6 func(F) G, f7 func(G) H, f8 func(H) I) func(A) I {
+	return func(a A) I {
+		return f8(f7(f6(f5(f4(f3(f2(f1(a))))))))
+	}
+}
+
+// Compose2 composes two functions right to left, as in mathematical
+// notation: Compose2(f1, f2)(a) is f1(f2(a)).
+//
+// Example:
+//
+//	slug := anygo.Compose2(strings.ToLower, strings.TrimSpace)
+func Compose2[A, B, C any](f1 func(B) C, f2 func(A) B) func(A) C {
+	return Pipe2(f2, f1)
+}
+
+// Compose3 is like Compose2 for three functions.
+func Compose3[A, B, C, D any](f1 func(C) D, f2 func(B) C, f3 func(A) B) func(A) D {
+	return Pipe3(f3, f2, f1)
+}
+
+// Compose4 is like Compose2 for four functions.
+func Compose4[A, B, C, D, E any](f1 func(D) E, f2 func(C) D, f3 func(B) C, f4 func(A) B) func(A) E {
+	return Pipe4(f4, f3, f2, f1)
+}
+
+// Compose5 is like Compose2 for five functions.
+func Compose5[A, B, C, D, E, F any](f1 func(E) F, f2 func(D) E, f3 func(C) D, f4 func(B) C, f5 func(A) B) func(A) F {
+	return Pipe5(f5, f4, f3, f2, f1)
+}
+
+// Compose6 is like Compose2 for six functions.
+func Compose6[A, B, C, D, E, F, G any](f1 func(F) G, f2 func(E) F, f3 func(D) E, f4 func(C) D, f5 func(B) C, f6 func(A) B) func(A) G {
+	return Pipe6(f6, f5, f4, f3, f2, f1)
+}
+
+// Compose7 is like Compose2 for seven functions.
+func Compose7[A, B, C, D, E, F, G, H any](f1 func(G) H, f2 func(F) G, f3 func(E) F, f4 func(D) E, f5 func(C) D, f6 func(B) C, f7 func(A) B) func(A) H {
+	return Pipe7(f7, f6, f5, f4, f3, f2, f1)
+}
+
+// Compose8 is like Compose2 for eight functions.
+func Compose8[A, B, C, D, E, F, G, H, I any](f1 func(H) I, f2 func(G) H, f3 func(F) G, f4 func(E) F, f5 func(D) E, f6 func(C) D, f7 func(B) C, f8 func(A) B) func(A) I {
+	return Pipe8(f8, f7, f6, f5, f4, f3, f2, f1)
+}
+
+// PipeR2 composes two Result-returning functions left to right: the
+// returned function calls f1, then f2 with its value, stopping at the
+// first Err.
+//
+// Example:
+//
+//	parseAge := anygo.PipeR2(anygo.Wrap1(strconv.Atoi), checkAge)
+//	r := parseAge("42")
+func PipeR2[A, B, C any](f1 func(A) Result[B], f2 func(B) Result[C]) func(A) Result[C] {
+	return func(a A) Result[C] {
+		b, err := f1(a).Unwrap()
+		if err != nil {
+			return Err[C](err)
+		}
+		return f2(b)
+	}
+}
+
+// PipeR3 is like PipeR2 for three functions.
+func PipeR3[A, B, C, D any](f1 func(A) Result[B], f2 func(B) Result[C], f3 func(C) Result[D]) func(A) Result[D] {
+	return func(a A) Result[D] {
+		b, err := f1(a).Unwrap()
+		if err != nil {
+			return Err[D](err)
+		}
+		c, err := f2(b).Unwrap()
+		if err != nil {
+			return Err[D](err)
+		}
+		return f3(c)
+	}
+}
+
+// PipeR4 is like PipeR2 for four functions.
+func PipeR4[A, B, C, D, E any](f1 func(A) Result[B], f2 func(B) Result[C], f3 func(C) Result[D], f4 func(D) Result[E]) func(A) Result[E] {
+	return func(a A) Result[E] {
+		b, err := f1(a).Unwrap()
+		if err != nil {
+			return Err[E](err)
+		}
+		c, err := f2(b).Unwrap()
+		if err != nil {
+			return Err[E](err)
+		}
+		d, err := f3(c).Unwrap()
+		if err != nil {
+			return Err[E](err)
+		}
+		return f4(d)
+	}
+}
+
+// PipeR5 is like PipeR2 for five functions.
+func PipeR5[A, B, C, D, E, F any](f1 func(A) Result[B], f2 func(B) Result[C], f3 func(C) Result[D], f4 func(D) Result[E], f5 func(E) Result[F]) func(A) Result[F] {
+	return func(a A) Result[F] {
+		b, err := f1(a).Unwrap()
+		if err != nil {
+			return Err[F](err)
+		}
+		c, err := f2(b).Unwrap()
+		if err != nil {
+			return Err[F](err)
+		}
+		d, err := f3(c).Unwrap()
+		if err != nil {
+			return Err[F](err)
+		}
+		e, err := f4(d).Unwrap()
+		if err != nil {
+			return Err[F](err)
+		}
+		return f5(e)
+	}
+}
+
+// PipeR6 is like PipeR2 for six functions.
+func PipeR6[A, B, C, D, E, F, G any](f1 func(A) Result[B], f2 func(B) Result[C], f3 func(C) Result[D], f4 func(D) Result[E], f5 func(E) Result[F], f6 func(F) Result[G]) func(A) Result[G] {
+	return func(a A) Result[G] {
+		b, err := f1(a).Unwrap()
+		if err != nil {
+			return Err[G](err)
+		}
+		c, err := f2(b).Unwrap()
+		if err != nil {
+			return Err[G](err)
+		}
+		d, err := f3(c).Unwrap()
+		if err != nil {
+			return Err[G](err)
+		}
+		e, err := f4(d).Unwrap()
+		if err != nil {
+			return Err[G](err)
+		}
+		f, err := f5(e).Unwrap()
+		if err != nil {
+			return Err[G](err)
+		}
+		return f6(f)
+	}
+}
+
+// PipeR7 is like PipeR2 for seven functions.
+func PipeR7[A, B, C, D, E, F, G, H any](f1 func(A) Result[B], f2 func(B) Result[C], f3 func(C) Result[D], f4 func(D) Result[E], f5 func(E) Result[F], f6 func(F) Result[G], f7 func(G) Result[H]) func(A) Result[H] {
+	return func(a A) Result[H] {
+		b, err := f1(a).Unwrap()
+		if err != nil {
+			return Err[H](err)
+		}
+		c, err := f2(b).Unwrap()
+		if err != nil {
+			return Err[H](err)
+		}
+		d, err := f3(c).Unwrap()
+		if err != nil {
+			return Err[H](err)
+		}
+		e, err := f4(d).Unwrap()
+		if err != nil {
+			return Err[H](err)
+		}
+		f, err := f5(e).Unwrap()
+		if err != nil {
+			return Err[H](err)
+		}
+		g, err := f6(f).Unwrap()
+		if err != nil {
+			return Err[H](err)
+		}
+		return f7(g)
+	}
+}
+
+// PipeR8 is like PipeR2 for eight functions.
+func PipeR8[A, B, C, D, E, F, G, H, I any](f1 func(A) Result[B], f2 func(B) Result[C], f3 func(C) Result[D], f4 func(D) Result[E], f5 func(E) Result[F], f6 func(F) Result[G], f7 func(G) Result[H], f8 func(H) Result[I]) func(A) Result[I] {
+	return func(a A) Result[I] {
+		b, err := f1(a).Unwrap()
+		if err != nil {
+			return Err[I](err)
+		}
+		c, err := f2(b).Unwrap()
+		if err != nil {
+			return Err[I](err)
+		}
+		d, err := f3(c).Unwrap()
+		if err != nil {
+			return Err[I](err)
+		}
+		e, err := f4(d).Unwrap()
+		if err != nil {
+			return Err[I](err)
+		}
+		f, err := f5(e).Unwrap()
+		if err != nil {
+			return Err[I](err)
+		}
+		g, err := f6(f).Unwrap()
+		if err != nil {
+			return Err[I](err)
+		}
+		h, err := f7(g).Unwrap()
+		if err != nil {
+			return Err[I](err)
+		}
+		return f8(h)
+	}
+}
+
+// ComposeR2 composes two Result-returning functions right to left, as in
+// mathematical notation: ComposeR2(f1, f2) is PipeR2(f2, f1).
+func ComposeR2[A, B, C any](f1 func(B) Result[C], f2 func(A) Result[B]) func(A) Result[C] {
+	return PipeR2(f2, f1)
+}
+
+// ComposeR3 is like ComposeR2 for three functions.
+func ComposeR3[A, B, C, D any](f1 func(C) Result[D], f2 func(B) Result[C], f3 func(A) Result[B]) func(A) Result[D] {
+	return PipeR3(f3, f2, f1)
+}
+
+// ComposeR4 is like ComposeR2 for four functions.
+func ComposeR4[A, B, C, D, E any](f1 func(D) Result[E], f2 func(C) Result[D], f3 func(B) Result[C], f4 func(A) Result[B]) func(A) Result[E] {
+	return PipeR4(f4, f3, f2, f1)
+}
+
+// ComposeR5 is like ComposeR2 for five functions.
+func ComposeR5[A, B, C, D, E, F any](f1 func(E) Result[F], f2 func(D) Result[E], f3 func(C) Result[D], f4 func(B) Result[C], f5 func(A) Result[B]) func(A) Result[F] {
+	return PipeR5(f5, f4, f3, f2, f1)
+}
+
+// ComposeR6 is like ComposeR2 for six functions.
+func ComposeR6[A, B, C, D, E, F, G any](f1 func(F) Result[G], f2 func(E) Result[F], f3 func(D) Result[E], f4 func(C) Result[D], f5 func(B) Result[C], f6 func(A) Result[B]) func(A) Result[G] {
+	return PipeR6(f6, f5, f4, f3, f2, f1)
+}
+
+// ComposeR7 is like ComposeR2 for seven functions.
+func ComposeR7[A, B, C, D, E, F, G, H any](f1 func(G) Result[H], f2 func(F) Result[G], f3 func(E) Result[F], f4 func(D) Result[E], f5 func(C) Result[D], f6 func(B) Result[C], f7 func(A) Result[B]) func(A) Result[H] {
+	return PipeR7(f7, f6, f5, f4, f3, f2, f1)
+}
+
+// ComposeR8 is like ComposeR2 for eight functions.
+func ComposeR8[A, B, C, D, E, F, G, H, I any](f1 func(H) Result[I], f2 func(G) Result[H], f3 func(F) Result[G], f4 func(E) Result[F], f5 func(D) Result[E], f6 func(C) Result[D], f7 func(B) Result[C], f8 func(A) Result[B]) func(A) Result[I] {
+	return PipeR8(f8, f7, f6, f5, f4, f3, f2, f1)
+}
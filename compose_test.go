@@ -0,0 +1,76 @@
+package anygo_test
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/daxartio/anygo"
+)
+
+func inc(i int) int { return i + 1 }
+
+func TestPipe2(t *testing.T) {
+	slug := anygo.Pipe2(strings.TrimSpace, strings.ToLower)
+	if got := slug("  Hello "); got != "hello" {
+		t.Fatalf("expected hello, got '%s'", got)
+	}
+}
+
+func TestPipeN(t *testing.T) {
+	f := anygo.Pipe8(inc, inc, inc, inc, inc, inc, inc, strconv.Itoa)
+	if got := f(0); got != "7" {
+		t.Fatalf("expected 7, got '%s'", got)
+	}
+	if got := anygo.Pipe3(inc, strconv.Itoa, strings.NewReader)(1).Len(); got != 1 {
+		t.Fatalf("expected length 1, got %d", got)
+	}
+}
+
+func TestCompose(t *testing.T) {
+	double := func(i int) int { return i * 2 }
+	if got := anygo.Compose2(double, inc)(1); got != 4 {
+		t.Fatalf("expected double(inc(1)) = 4, got %d", got)
+	}
+	if got := anygo.Compose4(strconv.Itoa, double, inc, inc)(0); got != "4" {
+		t.Fatalf("expected 4, got '%s'", got)
+	}
+}
+
+func TestPipeR(t *testing.T) {
+	errNegative := errors.New("negative")
+	checkPositive := func(i int) anygo.Result[int] {
+		if i < 0 {
+			return anygo.Err[int](errNegative)
+		}
+		return anygo.Ok(i)
+	}
+	calls := 0
+	last := func(i int) anygo.Result[string] {
+		calls++
+		return anygo.Ok(strconv.Itoa(i))
+	}
+	parse := anygo.PipeR3(anygo.Wrap1(strconv.Atoi), checkPositive, last)
+	if got := parse("42").MustUnwrap(); got != "42" {
+		t.Fatalf("expected 42, got '%s'", got)
+	}
+	if err := parse("-1").UnwrapError(); err != errNegative || calls != 1 {
+		t.Fatalf("expected short-circuit on negative, got %v after %d calls", err, calls)
+	}
+	if parse("x").IsOk() {
+		t.Fatal("expected parse error")
+	}
+}
+
+func TestComposeR(t *testing.T) {
+	okInc := func(i int) anygo.Result[int] { return anygo.Ok(i + 1) }
+	f := anygo.ComposeR2(func(i int) anygo.Result[string] { return anygo.Ok(strconv.Itoa(i)) }, okInc)
+	if got := f(1).MustUnwrap(); got != "2" {
+		t.Fatalf("expected 2, got '%s'", got)
+	}
+	g := anygo.ComposeR8(okInc, okInc, okInc, okInc, okInc, okInc, okInc, okInc)
+	if got := g(0).MustUnwrap(); got != 8 {
+		t.Fatalf("expected 8, got %d", got)
+	}
+}
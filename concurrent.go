@@ -0,0 +1,195 @@
+package anygo
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+)
+
+// ErrChanClosed is returned by FromChan when the channel is closed before a
+// Result is received.
+var ErrChanClosed = errors.New("anygo: channel closed")
+
+// WithTimeout runs f in a new goroutine with a context that expires after d
+// and returns its Result, or Err(context.DeadlineExceeded) if d elapses
+// first.
+//
+// f should return once its context is done; if it does not, it keeps
+// running in the background and its Result is discarded. A panic inside f
+// is recovered and returned as an Err.
+//
+// Example:
+//
+//	r := anygo.WithTimeout(time.Second, func(ctx context.Context) anygo.Result[Addr] {
+//		return lookup(ctx, host)
+//	})
+//	if errors.Is(r.UnwrapError(), context.DeadlineExceeded) {
+//		// moved on without the lookup
+//	}
+func WithTimeout[T any](d time.Duration, f func(context.Context) Result[T]) Result[T] {
+	ctx, cancel := context.WithTimeout(context.Background(), d)
+	defer cancel()
+	return WithContext(ctx, func() Result[T] { return f(ctx) })
+}
+
+// WithContext runs f in a new goroutine and returns its Result, or
+// Err(ctx.Err()) if ctx is done first. It gives a deadline to blocking calls
+// that do not accept a context.
+//
+// f cannot be stopped: if ctx is done first, f keeps running in the
+// background and its Result is discarded. A panic inside f is recovered and
+// returned as an Err.
+//
+// Example:
+//
+//	r := anygo.WithContext(ctx, func() anygo.Result[[]byte] {
+//		return anygo.Try(legacy.Fetch(key))
+//	})
+func WithContext[T any](ctx context.Context, f func() Result[T]) Result[T] {
+	if err := ctx.Err(); err != nil {
+		return Err[T](err)
+	}
+	ch := make(chan Result[T], 1)
+	go func() {
+		ch <- FromFunc(func() (T, error) { return f().Unwrap() })
+	}()
+
+	select {
+	case r := <-ch:
+		return r
+	case <-ctx.Done():
+		return Err[T](ctx.Err())
+	}
+}
+
+// FromChan blocks until a Result is received from ch and returns it.
+// It returns Err(ErrChanClosed) if ch is closed first, or Err(ctx.Err())
+// if ctx is done first.
+//
+// Example:
+//
+//	ch := make(chan anygo.Result[int], 1)
+//	go func() { ch <- compute() }()
+//	r := anygo.FromChan(ctx, ch)
+func FromChan[T any](ctx context.Context, ch <-chan Result[T]) Result[T] {
+	select {
+	case r, ok := <-ch:
+		if !ok {
+			return Err[T](ErrChanClosed)
+		}
+		return r
+	case <-ctx.Done():
+		return Err[T](ctx.Err())
+	}
+}
+
+// RunAll runs each function in its own goroutine with a context derived from
+// ctx. The first Err cancels that context. RunAll waits for every function to
+// return and then yields Ok with the values in input order, or the first
+// Err. A panic inside a function is recovered and returned as an Err.
+//
+// Example:
+//
+//	r := anygo.RunAll(ctx, []func(context.Context) anygo.Result[User]{
+//		func(ctx context.Context) anygo.Result[User] { return fetchUser(ctx, 1) },
+//		func(ctx context.Context) anygo.Result[User] { return fetchUser(ctx, 2) },
+//	})
+func RunAll[T any](ctx context.Context, fns []func(context.Context) Result[T]) Result[[]T] {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var (
+		wg       sync.WaitGroup
+		once     sync.Once
+		firstErr error
+	)
+	vals := make([]T, len(fns))
+	for i, f := range fns {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			r := FromFunc(func() (T, error) { return f(ctx).Unwrap() })
+			if r.IsErr() {
+				once.Do(func() {
+					firstErr = r.err
+					cancel()
+				})
+				return
+			}
+			vals[i] = r.value
+		}()
+	}
+	wg.Wait()
+
+	if firstErr != nil {
+		return Err[[]T](firstErr)
+	}
+	return Ok(vals)
+}
+
+// ParallelMap applies f to every item using at most workers goroutines and
+// returns the Results in input order. Items not started before ctx is done
+// get Err(ctx.Err()). A panic inside f is recovered and returned as an Err.
+// Values of workers below 1 mean a single worker.
+//
+// Example:
+//
+//	rs := anygo.ParallelMap(ctx, urls, 8, fetch)
+func ParallelMap[T, U any](ctx context.Context, items []T, workers int, f func(T) Result[U]) []Result[U] {
+	rs, _ := parallelMap(ctx, items, workers, f, false)
+	return rs
+}
+
+// ParallelCollect is like ParallelMap but stops starting new items after
+// the first Err and returns that error, or Ok with all values in input
+// order.
+func ParallelCollect[T, U any](ctx context.Context, items []T, workers int, f func(T) Result[U]) Result[[]U] {
+	rs, err := parallelMap(ctx, items, workers, f, true)
+	if err != nil {
+		return Err[[]U](err)
+	}
+	return Collect(rs)
+}
+
+// parallelMap runs f over items with a bounded number of workers. If
+// failFast is set, the first Err cancels the remaining items and is
+// returned.
+func parallelMap[T, U any](ctx context.Context, items []T, workers int, f func(T) Result[U], failFast bool) ([]Result[U], error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	workers = max(1, min(workers, len(items)))
+
+	var (
+		wg       sync.WaitGroup
+		once     sync.Once
+		firstErr error
+	)
+	rs := make([]Result[U], len(items))
+	next := make(chan int)
+	for range workers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range next {
+				if err := ctx.Err(); err != nil {
+					rs[i] = Err[U](err)
+					continue
+				}
+				rs[i] = FromFunc(func() (U, error) { return f(items[i]).Unwrap() })
+				if failFast && rs[i].IsErr() {
+					once.Do(func() {
+						firstErr = rs[i].err
+						cancel()
+					})
+				}
+			}
+		}()
+	}
+	for i := range items {
+		next <- i
+	}
+	close(next)
+	wg.Wait()
+	return rs, firstErr
+}
@@ -0,0 +1,178 @@
+package anygo_test
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/daxartio/anygo"
+)
+
+func TestWithTimeout(t *testing.T) {
+	r := anygo.WithTimeout(time.Second, func(ctx context.Context) anygo.Result[int] {
+		if _, ok := ctx.Deadline(); !ok {
+			return anygo.Err[int](errors.New("expected a deadline"))
+		}
+		return anygo.Ok(1)
+	})
+	if v := r.MustUnwrap(); v != 1 {
+		t.Fatalf("expected 1, got %d", v)
+	}
+}
+
+func TestWithTimeoutExpires(t *testing.T) {
+	done := make(chan error, 1)
+	r := anygo.WithTimeout(10*time.Millisecond, func(ctx context.Context) anygo.Result[int] {
+		<-ctx.Done()
+		done <- ctx.Err()
+		return anygo.Ok(1)
+	})
+	if !errors.Is(r.UnwrapError(), context.DeadlineExceeded) {
+		t.Fatalf("expected deadline exceeded, got %v", r.UnwrapError())
+	}
+	if err := <-done; !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected f to observe the deadline, got %v", err)
+	}
+}
+
+func TestWithContext(t *testing.T) {
+	if v := anygo.WithContext(context.Background(), func() anygo.Result[int] { return anygo.Ok(1) }).MustUnwrap(); v != 1 {
+		t.Fatalf("expected 1, got %d", v)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	release := make(chan struct{})
+	defer close(release)
+	go cancel()
+	r := anygo.WithContext(ctx, func() anygo.Result[int] {
+		<-release
+		return anygo.Ok(1)
+	})
+	if !errors.Is(r.UnwrapError(), context.Canceled) {
+		t.Fatalf("expected context.Canceled, got %v", r.UnwrapError())
+	}
+}
+
+func TestWithContextPanic(t *testing.T) {
+	r := anygo.WithContext(context.Background(), func() anygo.Result[int] { panic("boom") })
+	var pe *anygo.PanicError
+	if !errors.As(r.UnwrapError(), &pe) {
+		t.Fatalf("expected PanicError, got %v", r)
+	}
+}
+
+func TestFromChan(t *testing.T) {
+	ch := make(chan anygo.Result[int], 1)
+	ch <- anygo.Ok(5)
+	if v := anygo.FromChan(context.Background(), ch).MustUnwrap(); v != 5 {
+		t.Fatalf("expected 5, got %d", v)
+	}
+
+	close(ch)
+	if r := anygo.FromChan(context.Background(), ch); !errors.Is(r.UnwrapError(), anygo.ErrChanClosed) {
+		t.Fatalf("expected ErrChanClosed, got %v", r.UnwrapError())
+	}
+}
+
+func TestFromChanContextDone(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	r := anygo.FromChan(ctx, make(chan anygo.Result[int]))
+	if !errors.Is(r.UnwrapError(), context.Canceled) {
+		t.Fatalf("expected context.Canceled, got %v", r.UnwrapError())
+	}
+}
+
+func TestRunAll(t *testing.T) {
+	fns := []func(context.Context) anygo.Result[int]{
+		func(context.Context) anygo.Result[int] { time.Sleep(5 * time.Millisecond); return anygo.Ok(1) },
+		func(context.Context) anygo.Result[int] { return anygo.Ok(2) },
+	}
+	if got := anygo.RunAll(context.Background(), fns).MustUnwrap(); !slices.Equal(got, []int{1, 2}) {
+		t.Fatalf("expected [1 2], got %v", got)
+	}
+}
+
+func TestRunAllCancelsOnErr(t *testing.T) {
+	fail := errors.New("fail")
+	fns := []func(context.Context) anygo.Result[int]{
+		func(ctx context.Context) anygo.Result[int] {
+			<-ctx.Done()
+			return anygo.Err[int](ctx.Err())
+		},
+		func(context.Context) anygo.Result[int] { return anygo.Err[int](fail) },
+	}
+	if r := anygo.RunAll(context.Background(), fns); r.UnwrapError() != fail {
+		t.Fatalf("expected first error, got %v", r.UnwrapError())
+	}
+}
+
+func TestRunAllRecoversPanic(t *testing.T) {
+	fns := []func(context.Context) anygo.Result[int]{
+		func(context.Context) anygo.Result[int] { panic("boom") },
+	}
+	if r := anygo.RunAll(context.Background(), fns); !r.IsErr() {
+		t.Fatal("expected panic to become an error")
+	}
+}
+
+func TestParallelMap(t *testing.T) {
+	var running, peak atomic.Int32
+	square := func(i int) anygo.Result[int] {
+		n := running.Add(1)
+		defer running.Add(-1)
+		for {
+			p := peak.Load()
+			if n <= p || peak.CompareAndSwap(p, n) {
+				break
+			}
+		}
+		time.Sleep(time.Millisecond)
+		if i == 3 {
+			return anygo.Err[int](errors.New("three"))
+		}
+		return anygo.Ok(i * i)
+	}
+	rs := anygo.ParallelMap(context.Background(), []int{1, 2, 3, 4, 5, 6}, 2, square)
+	if len(rs) != 6 || rs[0].MustUnwrap() != 1 || !rs[2].IsErr() || rs[5].MustUnwrap() != 36 {
+		t.Fatalf("unexpected results %v", rs)
+	}
+	if p := peak.Load(); p > 2 {
+		t.Fatalf("expected at most 2 workers, saw %d", p)
+	}
+}
+
+func TestParallelMapContextDone(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	rs := anygo.ParallelMap(ctx, []int{1, 2}, 2, func(i int) anygo.Result[int] { return anygo.Ok(i) })
+	for _, r := range rs {
+		if !errors.Is(r.UnwrapError(), context.Canceled) {
+			t.Fatalf("expected context.Canceled, got %v", r.UnwrapError())
+		}
+	}
+}
+
+func TestParallelCollect(t *testing.T) {
+	double := func(i int) anygo.Result[int] { return anygo.Ok(i * 2) }
+	r := anygo.ParallelCollect(context.Background(), []int{1, 2, 3}, 4, double)
+	if got := r.MustUnwrap(); !slices.Equal(got, []int{2, 4, 6}) {
+		t.Fatalf("expected [2 4 6], got %v", got)
+	}
+
+	fail := errors.New("fail")
+	var calls atomic.Int32
+	r = anygo.ParallelCollect(context.Background(), make([]int, 100), 1, func(int) anygo.Result[int] {
+		calls.Add(1)
+		return anygo.Err[int](fail)
+	})
+	if r.UnwrapError() != fail {
+		t.Fatalf("expected first error, got %v", r.UnwrapError())
+	}
+	if n := calls.Load(); n != 1 {
+		t.Fatalf("expected to stop after the first error, got %d calls", n)
+	}
+}
@@ -0,0 +1,79 @@
+package anygo
+
+// If returns a if cond is true and b otherwise. Both values are evaluated
+// by the caller; use IfF to compute only the selected one.
+//
+// Example:
+//
+//	label := anygo.If(n == 1, "item", "items")
+func If[T any](cond bool, a, b T) T {
+	if cond {
+		return a
+	}
+	return b
+}
+
+// IfF calls and returns a if cond is true and b otherwise. Only the selected
+// function is called.
+//
+// Example:
+//
+//	cfg := anygo.IfF(path != "", func() Config { return load(path) }, defaultConfig)
+func IfF[T any](cond bool, a, b func() T) T {
+	if cond {
+		return a()
+	}
+	return b()
+}
+
+// Switcher is a fluent switch expression created by Switch.
+type Switcher[K comparable, V any] struct {
+	key     K
+	value   V
+	matched bool
+}
+
+// Switch starts a switch expression on k. The first matching Case wins and
+// Default returns its value, or the fallback if no case matched.
+//
+// Example:
+//
+//	text := anygo.Switch[string](code).
+//		Case(200, "ok").
+//		Case(404, "not found").
+//		Default("unknown")
+func Switch[V any, K comparable](k K) Switcher[K, V] {
+	return Switcher[K, V]{key: k}
+}
+
+// Case selects v if no earlier case matched and the key equals k.
+func (s Switcher[K, V]) Case(k K, v V) Switcher[K, V] {
+	if !s.matched && s.key == k {
+		s.value, s.matched = v, true
+	}
+	return s
+}
+
+// CaseF is like Case, but f is only called if the case is selected.
+func (s Switcher[K, V]) CaseF(k K, f func() V) Switcher[K, V] {
+	if !s.matched && s.key == k {
+		s.value, s.matched = f(), true
+	}
+	return s
+}
+
+// Default returns the selected value, or v if no case matched.
+func (s Switcher[K, V]) Default(v V) V {
+	if s.matched {
+		return s.value
+	}
+	return v
+}
+
+// Option returns the selected value as Some, or None if no case matched.
+func (s Switcher[K, V]) Option() Option[V] {
+	if s.matched {
+		return Some(s.value)
+	}
+	return None[V]()
+}
@@ -0,0 +1,47 @@
+package anygo_test
+
+import (
+	"testing"
+
+	"github.com/daxartio/anygo"
+)
+
+func TestIf(t *testing.T) {
+	if anygo.If(true, 1, 2) != 1 || anygo.If(false, 1, 2) != 2 {
+		t.Fatal("unexpected If result")
+	}
+}
+
+func TestIfF(t *testing.T) {
+	calls := 0
+	a := func() int { calls++; return 1 }
+	b := func() int { calls++; return 2 }
+	if anygo.IfF(false, a, b) != 2 || calls != 1 {
+		t.Fatal("expected only the selected branch to run")
+	}
+}
+
+func TestSwitch(t *testing.T) {
+	text := func(code int) string {
+		return anygo.Switch[string](code).
+			Case(200, "ok").
+			Case(404, "not found").
+			Case(200, "duplicate").
+			Default("unknown")
+	}
+	if text(200) != "ok" || text(404) != "not found" || text(500) != "unknown" {
+		t.Fatal("unexpected Switch result")
+	}
+
+	calls := 0
+	v := anygo.Switch[int]("b").
+		CaseF("a", func() int { calls++; return 1 }).
+		CaseF("b", func() int { calls++; return 2 }).
+		Option()
+	if got, ok := v.Unwrap(); !ok || got != 2 || calls != 1 {
+		t.Fatalf("expected lazy match 2, got %v after %d calls", got, calls)
+	}
+	if anygo.Switch[int]("z").Case("a", 1).Option().IsSome() {
+		t.Fatal("expected None without a match")
+	}
+}
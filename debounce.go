@@ -0,0 +1,175 @@
+package anygo
+
+import (
+	"sync"
+	"time"
+)
+
+// Debounced delays calls to a function until they stop arriving for a
+// while. It is safe for concurrent use. Create one with Debounce.
+type Debounced[T any] struct {
+	mu      sync.Mutex
+	wait    time.Duration
+	f       func(T)
+	timer   *time.Timer
+	value   T
+	pending bool
+	stopped bool
+}
+
+// Debounce returns a Debounced that calls f with the latest value passed to
+// Call once no new Call has arrived for d. f runs on its own goroutine.
+//
+// Example:
+//
+//	save := anygo.Debounce(500*time.Millisecond, func(doc Doc) { store.Save(doc) })
+//	defer save.Flush()
+//	for doc := range edits {
+//		save.Call(doc)
+//	}
+func Debounce[T any](d time.Duration, f func(T)) *Debounced[T] {
+	return &Debounced[T]{wait: d, f: f}
+}
+
+// Call records v and restarts the wait. It does nothing after Stop.
+func (d *Debounced[T]) Call(v T) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.stopped {
+		return
+	}
+	d.value, d.pending = v, true
+	if d.timer == nil {
+		d.timer = time.AfterFunc(d.wait, d.fire)
+	} else {
+		d.timer.Reset(d.wait)
+	}
+}
+
+// Flush calls f immediately with the pending value, if any, on the calling
+// goroutine.
+func (d *Debounced[T]) Flush() {
+	d.mu.Lock()
+	if d.timer != nil {
+		d.timer.Stop()
+	}
+	d.fireLocked()
+}
+
+// Stop discards the pending value, if any, and ignores later calls.
+func (d *Debounced[T]) Stop() {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.stopped, d.pending = true, false
+	if d.timer != nil {
+		d.timer.Stop()
+	}
+}
+
+func (d *Debounced[T]) fire() {
+	d.mu.Lock()
+	d.fireLocked()
+}
+
+// fireLocked calls f with the pending value. It must be called with d.mu
+// held and releases it before calling f.
+func (d *Debounced[T]) fireLocked() {
+	if !d.pending || d.stopped {
+		d.mu.Unlock()
+		return
+	}
+	v := d.value
+	d.pending = false
+	d.mu.Unlock()
+	d.f(v)
+}
+
+// Throttled limits calls to a function to at most one per interval. It is
+// safe for concurrent use. Create one with Throttle.
+type Throttled[T any] struct {
+	mu       sync.Mutex
+	interval time.Duration
+	f        func(T)
+	last     time.Time
+	timer    *time.Timer
+	value    T
+	pending  bool
+	stopped  bool
+}
+
+// Throttle returns a Throttled that calls f at most once per interval. A
+// Call outside the current interval runs f immediately on the calling
+// goroutine; calls inside it are coalesced, and f runs with the latest value
+// when the interval ends, on its own goroutine.
+//
+// Example:
+//
+//	report := anygo.Throttle(time.Second, func(p Progress) { log.Print(p) })
+//	for p := range updates {
+//		report.Call(p)
+//	}
+func Throttle[T any](interval time.Duration, f func(T)) *Throttled[T] {
+	return &Throttled[T]{interval: interval, f: f}
+}
+
+// Call runs f with v now, or schedules it for the end of the current
+// interval. It does nothing after Stop.
+func (t *Throttled[T]) Call(v T) {
+	t.mu.Lock()
+	if t.stopped {
+		t.mu.Unlock()
+		return
+	}
+	elapsed := time.Since(t.last)
+	if elapsed >= t.interval && !t.pending {
+		t.last = time.Now()
+		t.mu.Unlock()
+		t.f(v)
+		return
+	}
+	t.value = v
+	if !t.pending {
+		t.pending = true
+		t.timer = time.AfterFunc(t.interval-elapsed, t.fire)
+	}
+	t.mu.Unlock()
+}
+
+// Flush calls f immediately with the pending value, if any, on the calling
+// goroutine.
+func (t *Throttled[T]) Flush() {
+	t.mu.Lock()
+	if t.timer != nil {
+		t.timer.Stop()
+	}
+	t.fireLocked()
+}
+
+// Stop discards the pending value, if any, and ignores later calls.
+func (t *Throttled[T]) Stop() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.stopped, t.pending = true, false
+	if t.timer != nil {
+		t.timer.Stop()
+	}
+}
+
+func (t *Throttled[T]) fire() {
+	t.mu.Lock()
+	t.fireLocked()
+}
+
+// fireLocked calls f with the pending value. It must be called with t.mu
+// held and releases it before calling f.
+func (t *Throttled[T]) fireLocked() {
+	if !t.pending || t.stopped {
+		t.mu.Unlock()
+		return
+	}
+	v := t.value
+	t.pending = false
+	t.last = time.Now()
+	t.mu.Unlock()
+	t.f(v)
+}
@@ -0,0 +1,94 @@
+package anygo_test
+
+import (
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/daxartio/anygo"
+)
+
+// recorder collects values passed to a callback from any goroutine.
+type recorder struct {
+	mu     sync.Mutex
+	values []int
+}
+
+func (r *recorder) add(v int) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.values = append(r.values, v)
+}
+
+func (r *recorder) get() []int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return slices.Clone(r.values)
+}
+
+func TestDebounce(t *testing.T) {
+	var rec recorder
+	d := anygo.Debounce(20*time.Millisecond, rec.add)
+	for i := range 5 {
+		d.Call(i)
+	}
+	if got := rec.get(); len(got) != 0 {
+		t.Fatalf("expected no calls before the wait, got %v", got)
+	}
+	time.Sleep(60 * time.Millisecond)
+	if got := rec.get(); !slices.Equal(got, []int{4}) {
+		t.Fatalf("expected only the latest value, got %v", got)
+	}
+}
+
+func TestDebounceFlushAndStop(t *testing.T) {
+	var rec recorder
+	d := anygo.Debounce(time.Hour, rec.add)
+	d.Call(1)
+	d.Flush()
+	d.Flush()
+	if got := rec.get(); !slices.Equal(got, []int{1}) {
+		t.Fatalf("expected Flush to call once, got %v", got)
+	}
+	d.Call(2)
+	d.Stop()
+	d.Call(3)
+	d.Flush()
+	if got := rec.get(); !slices.Equal(got, []int{1}) {
+		t.Fatalf("expected Stop to discard values, got %v", got)
+	}
+}
+
+func TestThrottle(t *testing.T) {
+	var rec recorder
+	th := anygo.Throttle(30*time.Millisecond, rec.add)
+	th.Call(1)
+	th.Call(2)
+	th.Call(3)
+	if got := rec.get(); !slices.Equal(got, []int{1}) {
+		t.Fatalf("expected the leading call only, got %v", got)
+	}
+	time.Sleep(80 * time.Millisecond)
+	if got := rec.get(); !slices.Equal(got, []int{1, 3}) {
+		t.Fatalf("expected a trailing call with the latest value, got %v", got)
+	}
+}
+
+func TestThrottleFlushAndStop(t *testing.T) {
+	var rec recorder
+	th := anygo.Throttle(time.Hour, rec.add)
+	th.Call(1)
+	th.Call(2)
+	th.Flush()
+	if got := rec.get(); !slices.Equal(got, []int{1, 2}) {
+		t.Fatalf("expected Flush to deliver the pending value, got %v", got)
+	}
+	th.Call(3)
+	th.Stop()
+	th.Call(4)
+	th.Flush()
+	if got := rec.get(); !slices.Equal(got, []int{1, 2}) {
+		t.Fatalf("expected Stop to discard values, got %v", got)
+	}
+}
@@ -0,0 +1,38 @@
+package anygo
+
+// doAbort is the panic value used by Do to unwind on the first Err.
+type doAbort struct {
+	err error
+}
+
+// Do runs f and returns its value as an Ok Result. Inside f, the provided
+// unwrap function extracts the value of a Result; if that Result is Err, f is
+// aborted and Do returns the error, emulating Rust's ? operator.
+//
+// Only aborts raised by unwrap are recovered. Any other panic inside f is
+// propagated unchanged.
+//
+// Example:
+//
+//	r := anygo.Do(func(unwrap func(anygo.Result[any]) any) int {
+//		a := unwrap(parse("1")).(int)
+//		b := unwrap(parse("2")).(int)
+//		return a + b
+//	})
+func Do[T any](f func(unwrap func(Result[any]) any) T) (r Result[T]) {
+	defer func() {
+		if p := recover(); p != nil {
+			abort, ok := p.(doAbort)
+			if !ok {
+				panic(p)
+			}
+			r = Err[T](abort.err)
+		}
+	}()
+	return Ok(f(func(res Result[any]) any {
+		if res.IsErr() {
+			panic(doAbort{err: res.err})
+		}
+		return res.value
+	}))
+}
@@ -0,0 +1,46 @@
+package anygo_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/daxartio/anygo"
+)
+
+func TestDo(t *testing.T) {
+	r := anygo.Do(func(unwrap func(anygo.Result[any]) any) int {
+		a := unwrap(anygo.Ok[any](1)).(int)
+		b := unwrap(anygo.Ok[any](2)).(int)
+		return a + b
+	})
+	if v := r.MustUnwrap(); v != 3 {
+		t.Fatalf("expected 3, got %d", v)
+	}
+}
+
+func TestDoShortCircuits(t *testing.T) {
+	err := errors.New("fail")
+	reached := false
+	r := anygo.Do(func(unwrap func(anygo.Result[any]) any) int {
+		unwrap(anygo.Err[any](err))
+		reached = true
+		return 0
+	})
+	if r.UnwrapError() != err {
+		t.Fatal("expected original error")
+	}
+	if reached {
+		t.Fatal("expected Do to stop at the first Err")
+	}
+}
+
+func TestDoRepanics(t *testing.T) {
+	defer func() {
+		if r := recover(); r != "bug" {
+			t.Fatalf("expected foreign panic to propagate, got %v", r)
+		}
+	}()
+	anygo.Do(func(unwrap func(anygo.Result[any]) any) int {
+		panic("bug")
+	})
+}
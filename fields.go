@@ -0,0 +1,61 @@
+package anygo
+
+import (
+	"errors"
+	"maps"
+)
+
+// fieldsError wraps an error with structured key/value context.
+type fieldsError struct {
+	err    error
+	fields map[string]any
+}
+
+func (e *fieldsError) Error() string {
+	return e.err.Error()
+}
+
+func (e *fieldsError) Unwrap() error {
+	return e.err
+}
+
+// Fields returns a copy of the accumulated fields.
+func (e *fieldsError) Fields() map[string]any {
+	return maps.Clone(e.fields)
+}
+
+// WithField attaches a key/value pair to the error if Result is Err.
+// Fields accumulate across calls and can be read back with ErrorFields.
+// The error message is unchanged.
+//
+// Example:
+//
+//	r := loadUser(id).WithField("user_id", id).WithField("attempt", n)
+//	log.Println(anygo.ErrorFields(r.UnwrapError()))
+func (r Result[T]) WithField(key string, val any) Result[T] {
+	if r.IsOk() {
+		return r
+	}
+	fields := ErrorFields(r.err)
+	if fields == nil {
+		fields = make(map[string]any, 1)
+	}
+	fields[key] = val
+
+	err := r.err
+	if fe, ok := err.(*fieldsError); ok {
+		err = fe.err
+	}
+	return Err[T](&fieldsError{err: err, fields: fields})
+}
+
+// ErrorFields returns the fields attached to err by WithField, or nil if
+// there are none. Any error in the chain implementing
+// Fields() map[string]any is recognized.
+func ErrorFields(err error) map[string]any {
+	var fe interface{ Fields() map[string]any }
+	if !errors.As(err, &fe) {
+		return nil
+	}
+	return fe.Fields()
+}
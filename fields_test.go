@@ -0,0 +1,44 @@
+package anygo_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/daxartio/anygo"
+)
+
+func TestWithField(t *testing.T) {
+	sentinel := errors.New("not found")
+	r := anygo.Err[int](sentinel).WithField("id", 7).WithField("table", "users")
+	err := r.UnwrapError()
+	if err.Error() != "not found" {
+		t.Fatalf("expected message to be unchanged, got '%s'", err)
+	}
+	if !errors.Is(err, sentinel) {
+		t.Fatal("expected error to wrap the original")
+	}
+	fields := anygo.ErrorFields(err)
+	if len(fields) != 2 || fields["id"] != 7 || fields["table"] != "users" {
+		t.Fatalf("unexpected fields: %v", fields)
+	}
+}
+
+func TestWithFieldThroughWrapping(t *testing.T) {
+	r := anygo.Err[int](errors.New("fail")).WithField("a", 1).WrapErr("ctx").WithField("b", 2)
+	fields := anygo.ErrorFields(r.UnwrapError())
+	if fields["a"] != 1 || fields["b"] != 2 {
+		t.Fatalf("expected fields to accumulate across wrapping, got %v", fields)
+	}
+	if r.UnwrapError().Error() != "ctx: fail" {
+		t.Fatalf("unexpected message '%s'", r.UnwrapError())
+	}
+}
+
+func TestWithFieldOk(t *testing.T) {
+	if v := anygo.Ok(1).WithField("a", 1).MustUnwrap(); v != 1 {
+		t.Fatal("expected Ok to pass through")
+	}
+	if anygo.ErrorFields(errors.New("plain")) != nil {
+		t.Fatal("expected no fields on a plain error")
+	}
+}
@@ -0,0 +1,54 @@
+package anygo
+
+import (
+	"errors"
+	"fmt"
+	"io"
+)
+
+// String returns "Ok(value)" or "Err(message)".
+func (r Result[T]) String() string {
+	return fmt.Sprint(r)
+}
+
+// Format implements fmt.Formatter. An Ok Result prints as Ok(value), with
+// the verb and flags applied to the value. An Err prints as Err(message);
+// with %+v it also lists the wrapped error chain and, if one was recorded,
+// the call stack.
+//
+// Example:
+//
+//	fmt.Printf("%v\n", anygo.Ok(42))                       // Ok(42)
+//	fmt.Printf("%v\n", anygo.Err[int](errors.New("boom"))) // Err(boom)
+func (r Result[T]) Format(s fmt.State, verb rune) {
+	if r.IsOk() {
+		io.WriteString(s, "Ok(")
+		fmt.Fprintf(s, fmt.FormatString(s, verb), r.value)
+		io.WriteString(s, ")")
+		return
+	}
+
+	io.WriteString(s, "Err(")
+	if verb == 'q' {
+		fmt.Fprintf(s, "%q", r.err.Error())
+	} else {
+		io.WriteString(s, r.err.Error())
+	}
+	io.WriteString(s, ")")
+	if verb == 'v' && s.Flag('+') {
+		writeCauses(s, r.err)
+		writeFrames(s, r.Stack())
+	}
+}
+
+// writeCauses prints the message of each error in the Unwrap chain of err
+// that adds a message of its own.
+func writeCauses(w io.Writer, err error) {
+	prev := err.Error()
+	for cause := errors.Unwrap(err); cause != nil; cause = errors.Unwrap(cause) {
+		if msg := cause.Error(); msg != prev {
+			fmt.Fprintf(w, "\n\tcaused by: %s", msg)
+			prev = msg
+		}
+	}
+}
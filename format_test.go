@@ -0,0 +1,52 @@
+package anygo_test
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/daxartio/anygo"
+)
+
+func TestResultString(t *testing.T) {
+	if s := anygo.Ok(42).String(); s != "Ok(42)" {
+		t.Fatalf("expected Ok(42), got '%s'", s)
+	}
+	if s := anygo.Err[int](errors.New("boom")).String(); s != "Err(boom)" {
+		t.Fatalf("expected Err(boom), got '%s'", s)
+	}
+}
+
+func TestResultFormatVerbs(t *testing.T) {
+	if s := fmt.Sprintf("%q", anygo.Ok("x")); s != `Ok("x")` {
+		t.Fatalf("unexpected %%q output '%s'", s)
+	}
+	if s := fmt.Sprintf("%03d", anygo.Ok(7)); s != "Ok(007)" {
+		t.Fatalf("expected flags to apply to the value, got '%s'", s)
+	}
+	type point struct{ X, Y int }
+	if s := fmt.Sprintf("%+v", anygo.Ok(point{1, 2})); s != "Ok({X:1 Y:2})" {
+		t.Fatalf("unexpected %%+v output '%s'", s)
+	}
+}
+
+func TestResultFormatChain(t *testing.T) {
+	base := errors.New("no such file")
+	r := anygo.Err[int](fmt.Errorf("load config: %w", fmt.Errorf("open x: %w", base)))
+	want := "Err(load config: open x: no such file)\n\tcaused by: open x: no such file\n\tcaused by: no such file"
+	if s := fmt.Sprintf("%+v", r); s != want {
+		t.Fatalf("expected:\n%s\ngot:\n%s", want, s)
+	}
+	if s := fmt.Sprintf("%v", r); strings.Contains(s, "caused by") {
+		t.Fatalf("expected no chain with %%v, got '%s'", s)
+	}
+}
+
+func TestResultFormatStack(t *testing.T) {
+	r := anygo.ErrWithStack[int](errors.New("oops"))
+	s := fmt.Sprintf("%+v", r)
+	if !strings.HasPrefix(s, "Err(oops)\n") || !strings.Contains(s, "TestResultFormatStack") {
+		t.Fatalf("expected stack in output, got '%s'", s)
+	}
+}
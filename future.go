@@ -0,0 +1,82 @@
+package anygo
+
+import "context"
+
+// Future is a Result that is being computed asynchronously.
+type Future[T any] struct {
+	state *futureState[T]
+}
+
+type futureState[T any] struct {
+	done   chan struct{}
+	result Result[T]
+}
+
+// Go runs f in a new goroutine and returns a Future for its result.
+// A panic inside f is recovered and returned as an Err.
+//
+// Example:
+//
+//	f := anygo.Go(func() (User, error) { return fetchUser(id) })
+//	r := f.Await(ctx)
+func Go[T any](f func() (T, error)) Future[T] {
+	return spawn(func() Result[T] { return FromFunc(f) })
+}
+
+// spawn runs f in a new goroutine and completes the returned Future with
+// its Result.
+func spawn[T any](f func() Result[T]) Future[T] {
+	state := &futureState[T]{done: make(chan struct{})}
+	go func() {
+		defer close(state.done)
+		state.result = f()
+	}()
+	return Future[T]{state: state}
+}
+
+// Await blocks until the Future completes and returns its Result, or
+// returns Err(ctx.Err()) if ctx is done first.
+func (f Future[T]) Await(ctx context.Context) Result[T] {
+	select {
+	case <-f.state.done:
+		return f.state.result
+	case <-ctx.Done():
+		return Err[T](ctx.Err())
+	}
+}
+
+// Map returns a Future that applies fn to the value once f completes.
+func (f Future[T]) Map(fn func(T) T) Future[T] {
+	return Then(f, func(v T) Result[T] { return Ok(fn(v)) })
+}
+
+// Then returns a Future that chains fn on the value once f completes.
+// A panic inside fn is recovered and returned as an Err.
+//
+// Example:
+//
+//	orders := anygo.Then(anygo.Go(loadUser), func(u User) anygo.Result[[]Order] {
+//		return loadOrders(u.ID)
+//	})
+func Then[T, U any](f Future[T], fn func(T) Result[U]) Future[U] {
+	return spawn(func() Result[U] {
+		<-f.state.done
+		return FromFunc(func() (U, error) {
+			return AndThen(f.state.result, fn).Unwrap()
+		})
+	})
+}
+
+// AwaitAll waits for every Future and returns their values in order, or the
+// first Err in argument order.
+func AwaitAll[T any](ctx context.Context, fs ...Future[T]) Result[[]T] {
+	vals := make([]T, 0, len(fs))
+	for _, f := range fs {
+		r := f.Await(ctx)
+		if r.IsErr() {
+			return Err[[]T](r.err)
+		}
+		vals = append(vals, r.value)
+	}
+	return Ok(vals)
+}
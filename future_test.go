@@ -0,0 +1,74 @@
+package anygo_test
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strconv"
+	"testing"
+
+	"github.com/daxartio/anygo"
+)
+
+func TestGoAwait(t *testing.T) {
+	f := anygo.Go(func() (int, error) { return 42, nil })
+	if v := f.Await(context.Background()).MustUnwrap(); v != 42 {
+		t.Fatalf("expected 42, got %d", v)
+	}
+	if v := f.Await(context.Background()).MustUnwrap(); v != 42 {
+		t.Fatal("expected Await to be repeatable")
+	}
+}
+
+func TestGoRecoversPanic(t *testing.T) {
+	f := anygo.Go(func() (int, error) { panic("boom") })
+	var perr *anygo.PanicError
+	if r := f.Await(context.Background()); !r.As(&perr) {
+		t.Fatalf("expected PanicError, got %v", r.UnwrapError())
+	}
+}
+
+func TestAwaitContextDone(t *testing.T) {
+	release := make(chan struct{})
+	defer close(release)
+	f := anygo.Go(func() (int, error) { <-release; return 1, nil })
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if r := f.Await(ctx); !errors.Is(r.UnwrapError(), context.Canceled) {
+		t.Fatalf("expected context.Canceled, got %v", r.UnwrapError())
+	}
+}
+
+func TestFutureMapThen(t *testing.T) {
+	f := anygo.Go(func() (int, error) { return 2, nil }).Map(func(i int) int { return i * 10 })
+	s := anygo.Then(f, func(i int) anygo.Result[string] { return anygo.Ok(strconv.Itoa(i)) })
+	if v := s.Await(context.Background()).MustUnwrap(); v != "20" {
+		t.Fatalf("expected '20', got %q", v)
+	}
+
+	err := errors.New("fail")
+	failed := anygo.Then(anygo.Go(func() (int, error) { return 0, err }), func(i int) anygo.Result[string] {
+		t.Error("expected Then not to run after an error")
+		return anygo.Ok("")
+	})
+	if r := failed.Await(context.Background()); r.UnwrapError() != err {
+		t.Fatalf("expected original error, got %v", r.UnwrapError())
+	}
+}
+
+func TestAwaitAll(t *testing.T) {
+	fs := []anygo.Future[int]{
+		anygo.Go(func() (int, error) { return 1, nil }),
+		anygo.Go(func() (int, error) { return 2, nil }),
+	}
+	if got := anygo.AwaitAll(context.Background(), fs...).MustUnwrap(); !slices.Equal(got, []int{1, 2}) {
+		t.Fatalf("expected [1 2], got %v", got)
+	}
+
+	err := errors.New("fail")
+	fs = append(fs, anygo.Go(func() (int, error) { return 0, err }))
+	if r := anygo.AwaitAll(context.Background(), fs...); r.UnwrapError() != err {
+		t.Fatalf("expected error, got %v", r.UnwrapError())
+	}
+}
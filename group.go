@@ -0,0 +1,100 @@
+package anygo
+
+import (
+	"context"
+	"sync"
+)
+
+// Group runs tasks in goroutines and collects their Results, in the manner
+// of errgroup. The zero value runs tasks without a limit and does not
+// cancel anything on failure.
+type Group[T any] struct {
+	wg      sync.WaitGroup
+	sem     chan struct{}
+	cancel  context.CancelFunc
+	mu      sync.Mutex
+	results []Result[T]
+	errOnce sync.Once
+	err     error
+}
+
+// NewGroup returns a Group and a context derived from ctx that is canceled
+// when the first task returns an Err or when Wait or WaitAll returns.
+//
+// Example:
+//
+//	g, ctx := anygo.NewGroup[User](ctx)
+//	g.SetLimit(4)
+//	for _, id := range ids {
+//		g.Go(func() anygo.Result[User] { return fetchUser(ctx, id) })
+//	}
+//	users := g.Wait()
+func NewGroup[T any](ctx context.Context) (*Group[T], context.Context) {
+	ctx, cancel := context.WithCancel(ctx)
+	return &Group[T]{cancel: cancel}, ctx
+}
+
+// SetLimit limits the number of tasks running at once to n; Go blocks until
+// a slot is free. Values below 1 remove the limit. SetLimit must not be
+// called while tasks are running.
+func (g *Group[T]) SetLimit(n int) {
+	if n < 1 {
+		g.sem = nil
+		return
+	}
+	g.sem = make(chan struct{}, n)
+}
+
+// Go runs f in a new goroutine. A panic inside f is recovered and recorded
+// as an Err.
+func (g *Group[T]) Go(f func() Result[T]) {
+	if g.sem != nil {
+		g.sem <- struct{}{}
+	}
+	g.mu.Lock()
+	i := len(g.results)
+	g.results = append(g.results, Result[T]{})
+	g.mu.Unlock()
+
+	g.wg.Add(1)
+	go func() {
+		defer g.wg.Done()
+		if g.sem != nil {
+			defer func() { <-g.sem }()
+		}
+		r := FromFunc(func() (T, error) { return f().Unwrap() })
+		g.mu.Lock()
+		g.results[i] = r
+		g.mu.Unlock()
+		if r.IsErr() {
+			g.errOnce.Do(func() {
+				g.err = r.err
+				if g.cancel != nil {
+					g.cancel()
+				}
+			})
+		}
+	}()
+}
+
+// Wait waits for every task and returns Ok with their values in the order
+// Go was called, or the first Err that occurred.
+func (g *Group[T]) Wait() Result[[]T] {
+	rs := g.WaitAll()
+	if g.err != nil {
+		return Err[[]T](g.err)
+	}
+	return Collect(rs)
+}
+
+// WaitAll waits for every task and returns all Results in the order Go was
+// called.
+func (g *Group[T]) WaitAll() []Result[T] {
+	g.wg.Wait()
+	if g.cancel != nil {
+		g.cancel()
+	}
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	return append([]Result[T](nil), g.results...)
+}
@@ -0,0 +1,77 @@
+package anygo_test
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/daxartio/anygo"
+)
+
+func TestGroupWait(t *testing.T) {
+	g, _ := anygo.NewGroup[int](context.Background())
+	for i := range 5 {
+		g.Go(func() anygo.Result[int] {
+			time.Sleep(time.Duration(5-i) * time.Millisecond)
+			return anygo.Ok(i)
+		})
+	}
+	if got := g.Wait().MustUnwrap(); !slices.Equal(got, []int{0, 1, 2, 3, 4}) {
+		t.Fatalf("expected values in Go order, got %v", got)
+	}
+}
+
+func TestGroupFailFast(t *testing.T) {
+	boom := errors.New("boom")
+	g, ctx := anygo.NewGroup[int](context.Background())
+	g.Go(func() anygo.Result[int] { return anygo.Err[int](boom) })
+	g.Go(func() anygo.Result[int] {
+		<-ctx.Done()
+		return anygo.Err[int](ctx.Err())
+	})
+	if err := g.Wait().UnwrapError(); err != boom {
+		t.Fatalf("expected boom, got %v", err)
+	}
+}
+
+func TestGroupWaitAll(t *testing.T) {
+	var g anygo.Group[int]
+	g.Go(func() anygo.Result[int] { return anygo.Ok(1) })
+	g.Go(func() anygo.Result[int] { return anygo.Err[int](errors.New("fail")) })
+	g.Go(func() anygo.Result[int] { panic("boom") })
+	rs := g.WaitAll()
+	if len(rs) != 3 || rs[0].MustUnwrap() != 1 || rs[1].IsOk() {
+		t.Fatalf("unexpected results %v", rs)
+	}
+	var pe *anygo.PanicError
+	if !errors.As(rs[2].UnwrapError(), &pe) {
+		t.Fatalf("expected PanicError, got %v", rs[2])
+	}
+}
+
+func TestGroupLimit(t *testing.T) {
+	var g anygo.Group[int]
+	g.SetLimit(2)
+	var running, peak atomic.Int32
+	for range 10 {
+		g.Go(func() anygo.Result[int] {
+			n := running.Add(1)
+			for {
+				p := peak.Load()
+				if n <= p || peak.CompareAndSwap(p, n) {
+					break
+				}
+			}
+			time.Sleep(time.Millisecond)
+			running.Add(-1)
+			return anygo.Ok(0)
+		})
+	}
+	g.Wait()
+	if peak.Load() > 2 {
+		t.Fatalf("expected at most 2 concurrent tasks, got %d", peak.Load())
+	}
+}
@@ -0,0 +1,135 @@
+package anygo
+
+import (
+	"bufio"
+	"iter"
+)
+
+// OkValues returns a sequence of the values of the Ok Results in seq.
+// Err Results are skipped. The source is consumed lazily.
+//
+// Example:
+//
+//	for v := range anygo.OkValues(results) {
+//		fmt.Println(v)
+//	}
+func OkValues[T any](seq iter.Seq[Result[T]]) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for r := range seq {
+			if r.IsOk() && !yield(r.value) {
+				return
+			}
+		}
+	}
+}
+
+// ErrValues returns a sequence of the errors of the Err Results in seq.
+// Ok Results are skipped. The source is consumed lazily.
+func ErrValues[T any](seq iter.Seq[Result[T]]) iter.Seq[error] {
+	return func(yield func(error) bool) {
+		for r := range seq {
+			if r.IsErr() && !yield(r.err) {
+				return
+			}
+		}
+	}
+}
+
+// SeqToResults converts a sequence of (value, error) pairs into a sequence
+// of Results.
+//
+// Example:
+//
+//	nums := anygo.CollectSeq(anygo.SeqToResults(rows.All()))
+func SeqToResults[T any](seq iter.Seq2[T, error]) iter.Seq[Result[T]] {
+	return func(yield func(Result[T]) bool) {
+		for v, err := range seq {
+			if !yield(Try(v, err)) {
+				return
+			}
+		}
+	}
+}
+
+// ResultsToSeq converts a sequence of Results into a sequence of
+// (value, error) pairs. It is the inverse of SeqToResults.
+func ResultsToSeq[T any](seq iter.Seq[Result[T]]) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		for r := range seq {
+			if !yield(r.Unwrap()) {
+				return
+			}
+		}
+	}
+}
+
+// MapSeq returns a sequence that lazily applies f to each element of in.
+//
+// Example:
+//
+//	seq := anygo.MapSeq([]string{"1", "2"}, parseInt)
+//	r := anygo.CollectSeq(seq)
+func MapSeq[T, U any](in []T, f func(T) Result[U]) iter.Seq[Result[U]] {
+	return func(yield func(Result[U]) bool) {
+		for _, v := range in {
+			if !yield(f(v)) {
+				return
+			}
+		}
+	}
+}
+
+// CollectSeq gathers the values of seq into a slice. It stops pulling from
+// seq at the first Err and returns that error.
+func CollectSeq[T any](seq iter.Seq[Result[T]]) Result[[]T] {
+	vals := []T{}
+	for r := range seq {
+		if r.IsErr() {
+			return Err[[]T](r.err)
+		}
+		vals = append(vals, r.value)
+	}
+	return Ok(vals)
+}
+
+// ScanResults returns a sequence that yields parse applied to each token of
+// s. If the scanner stops with an error, it is yielded as a final Err.
+//
+// Example:
+//
+//	s := bufio.NewScanner(r)
+//	nums := anygo.CollectSeq(anygo.ScanResults(s, parseInt))
+func ScanResults[T any](s *bufio.Scanner, parse func(string) Result[T]) iter.Seq[Result[T]] {
+	return func(yield func(Result[T]) bool) {
+		for s.Scan() {
+			if !yield(parse(s.Text())) {
+				return
+			}
+		}
+		if err := s.Err(); err != nil {
+			yield(Err[T](err))
+		}
+	}
+}
+
+// TryReduce folds the values of seq from left to right with f. It stops
+// pulling from seq at the first Err and returns that error. An empty
+// sequence yields Ok(None).
+//
+// Example:
+//
+//	sum := anygo.TryReduce(nums, func(acc, n int) int { return acc + n })
+func TryReduce[T any](seq iter.Seq[Result[T]], f func(acc, next T) T) Result[Option[T]] {
+	acc := None[T]()
+	for r := range seq {
+		if r.IsErr() {
+			return Err[Option[T]](r.err)
+		}
+		if acc.IsNone() {
+			acc = Some(r.value)
+			continue
+		}
+		acc = Some(f(acc.value, r.value))
+	}
+	return Ok(acc)
+}
@@ -0,0 +1,167 @@
+package anygo_test
+
+import (
+	"bufio"
+	"errors"
+	"slices"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/daxartio/anygo"
+)
+
+func TestOkValues(t *testing.T) {
+	seq := slices.Values([]anygo.Result[int]{
+		anygo.Ok(1),
+		anygo.Err[int](errors.New("fail")),
+		anygo.Ok(3),
+	})
+	if got := slices.Collect(anygo.OkValues(seq)); !slices.Equal(got, []int{1, 3}) {
+		t.Fatalf("expected [1 3], got %v", got)
+	}
+}
+
+func TestErrValues(t *testing.T) {
+	err := errors.New("fail")
+	seq := slices.Values([]anygo.Result[int]{anygo.Ok(1), anygo.Err[int](err)})
+	got := slices.Collect(anygo.ErrValues(seq))
+	if len(got) != 1 || got[0] != err {
+		t.Fatalf("expected [fail], got %v", got)
+	}
+}
+
+func TestOkValuesIsLazy(t *testing.T) {
+	pulled := 0
+	seq := func(yield func(anygo.Result[int]) bool) {
+		for i := range 10 {
+			pulled++
+			if !yield(anygo.Ok(i)) {
+				return
+			}
+		}
+	}
+	for range anygo.OkValues(seq) {
+		break
+	}
+	if pulled != 1 {
+		t.Fatalf("expected 1 pull, got %d", pulled)
+	}
+}
+
+func TestMapSeq(t *testing.T) {
+	parse := func(s string) anygo.Result[int] {
+		return anygo.FromFunc(func() (int, error) { return strconv.Atoi(s) })
+	}
+	r := anygo.CollectSeq(anygo.MapSeq([]string{"1", "2", "3"}, parse))
+	if got := r.MustUnwrap(); !slices.Equal(got, []int{1, 2, 3}) {
+		t.Fatalf("expected [1 2 3], got %v", got)
+	}
+}
+
+func TestCollectSeqFailFast(t *testing.T) {
+	calls := 0
+	f := func(i int) anygo.Result[int] {
+		calls++
+		if i == 2 {
+			return anygo.Err[int](errors.New("bad"))
+		}
+		return anygo.Ok(i)
+	}
+	r := anygo.CollectSeq(anygo.MapSeq([]int{1, 2, 3, 4}, f))
+	if !r.IsErr() {
+		t.Fatal("expected Err result")
+	}
+	if calls != 2 {
+		t.Fatalf("expected 2 calls, got %d", calls)
+	}
+}
+
+func TestCollectSeqEmpty(t *testing.T) {
+	got := anygo.CollectSeq(anygo.MapSeq(nil, anygo.Ok[int])).MustUnwrap()
+	if got == nil || len(got) != 0 {
+		t.Fatalf("expected an empty non-nil slice like Collect, got %#v", got)
+	}
+}
+
+func TestScanResults(t *testing.T) {
+	parse := func(s string) anygo.Result[int] {
+		return anygo.FromFunc(func() (int, error) { return strconv.Atoi(s) })
+	}
+	s := bufio.NewScanner(strings.NewReader("1\n2\n3\n"))
+	if got := anygo.CollectSeq(anygo.ScanResults(s, parse)).MustUnwrap(); !slices.Equal(got, []int{1, 2, 3}) {
+		t.Fatalf("expected [1 2 3], got %v", got)
+	}
+}
+
+func TestScanResultsScannerError(t *testing.T) {
+	s := bufio.NewScanner(strings.NewReader(strings.Repeat("x", 100)))
+	s.Buffer(make([]byte, 10), 10)
+	rs := slices.Collect(anygo.ScanResults(s, anygo.Ok[string]))
+	if len(rs) != 1 || !errors.Is(rs[0].UnwrapError(), bufio.ErrTooLong) {
+		t.Fatalf("expected trailing scanner error, got %v", rs)
+	}
+}
+
+func TestTryReduce(t *testing.T) {
+	add := func(acc, n int) int { return acc + n }
+	seq := slices.Values([]anygo.Result[int]{anygo.Ok(1), anygo.Ok(2), anygo.Ok(3)})
+	if v, ok := anygo.TryReduce(seq, add).MustUnwrap().Unwrap(); !ok || v != 6 {
+		t.Fatalf("expected Some(6), got %d", v)
+	}
+
+	empty := slices.Values([]anygo.Result[int]{})
+	if o := anygo.TryReduce(empty, add).MustUnwrap(); !o.IsNone() {
+		t.Fatal("expected None for empty sequence")
+	}
+}
+
+func TestTryReduceStopsAtErr(t *testing.T) {
+	pulled := 0
+	err := errors.New("bad")
+	seq := func(yield func(anygo.Result[int]) bool) {
+		for _, r := range []anygo.Result[int]{anygo.Ok(1), anygo.Err[int](err), anygo.Ok(3)} {
+			pulled++
+			if !yield(r) {
+				return
+			}
+		}
+	}
+	r := anygo.TryReduce(seq, func(acc, n int) int { return acc + n })
+	if r.UnwrapError() != err || pulled != 2 {
+		t.Fatalf("expected to stop at the first error, pulled %d", pulled)
+	}
+}
+
+func TestSeqToResults(t *testing.T) {
+	fail := errors.New("fail")
+	seq := func(yield func(int, error) bool) {
+		_ = yield(1, nil) && yield(0, fail) && yield(3, nil)
+	}
+	rs := slices.Collect(anygo.SeqToResults(seq))
+	if len(rs) != 3 || rs[0].MustUnwrap() != 1 || rs[1].UnwrapError() != fail {
+		t.Fatalf("unexpected results %v", rs)
+	}
+	if r := anygo.CollectSeq(anygo.SeqToResults(seq)); r.UnwrapError() != fail {
+		t.Fatal("expected CollectSeq to stop at the error")
+	}
+}
+
+func TestResultsToSeq(t *testing.T) {
+	fail := errors.New("fail")
+	seq := slices.Values([]anygo.Result[int]{anygo.Ok(1), anygo.Err[int](fail)})
+	var (
+		vals []int
+		errs []error
+	)
+	for v, err := range anygo.ResultsToSeq(seq) {
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		vals = append(vals, v)
+	}
+	if !slices.Equal(vals, []int{1}) || len(errs) != 1 || errs[0] != fail {
+		t.Fatalf("unexpected values %v and errors %v", vals, errs)
+	}
+}
@@ -0,0 +1,79 @@
+package anygo
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+)
+
+// resultJSON is the wire form of a Result.
+type resultJSON[T any] struct {
+	Ok    *T      `json:"ok,omitempty"`
+	Error *string `json:"error,omitempty"`
+}
+
+// MarshalJSON implements json.Marshaler. An Ok Result is encoded as
+// {"ok": value} and an Err Result as {"error": "message"}.
+//
+// Example:
+//
+//	b, _ := json.Marshal(anygo.Ok(1))
+//	fmt.Println(string(b)) // {"ok":1}
+func (r Result[T]) MarshalJSON() ([]byte, error) {
+	if r.IsErr() {
+		msg := r.err.Error()
+		return json.Marshal(resultJSON[T]{Error: &msg})
+	}
+	return json.Marshal(resultJSON[T]{Ok: &r.value})
+}
+
+// UnmarshalJSON implements json.Unmarshaler for the form produced by
+// MarshalJSON. The error of a decoded Err Result only carries the message.
+func (r *Result[T]) UnmarshalJSON(data []byte) error {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+	if raw, ok := fields["error"]; ok {
+		var msg string
+		if err := json.Unmarshal(raw, &msg); err != nil {
+			return err
+		}
+		*r = Err[T](errors.New(msg))
+		return nil
+	}
+	raw, ok := fields["ok"]
+	if !ok {
+		return errors.New(`anygo: result JSON has neither "ok" nor "error"`)
+	}
+	var val T
+	if err := json.Unmarshal(raw, &val); err != nil {
+		return err
+	}
+	*r = Ok(val)
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler. Some is encoded as the value and
+// None as null.
+func (o Option[T]) MarshalJSON() ([]byte, error) {
+	if o.IsNone() {
+		return []byte("null"), nil
+	}
+	return json.Marshal(o.value)
+}
+
+// UnmarshalJSON implements json.Unmarshaler. null decodes to None and any
+// other value to Some.
+func (o *Option[T]) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(bytes.TrimSpace(data), []byte("null")) {
+		*o = None[T]()
+		return nil
+	}
+	var val T
+	if err := json.Unmarshal(data, &val); err != nil {
+		return err
+	}
+	*o = Some(val)
+	return nil
+}
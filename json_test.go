@@ -0,0 +1,60 @@
+package anygo_test
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/daxartio/anygo"
+)
+
+func TestResultMarshalJSON(t *testing.T) {
+	b, err := json.Marshal(anygo.Ok(1))
+	if err != nil || string(b) != `{"ok":1}` {
+		t.Fatalf("unexpected JSON %s (%v)", b, err)
+	}
+
+	b, _ = json.Marshal(anygo.Ok(0))
+	if string(b) != `{"ok":0}` {
+		t.Fatalf("expected zero value to be kept, got %s", b)
+	}
+
+	b, _ = json.Marshal(anygo.Err[int](errors.New("bad")))
+	if string(b) != `{"error":"bad"}` {
+		t.Fatalf("unexpected JSON %s", b)
+	}
+}
+
+func TestResultUnmarshalJSON(t *testing.T) {
+	var r anygo.Result[[]int]
+	if err := json.Unmarshal([]byte(`{"ok":[1,2]}`), &r); err != nil || len(r.MustUnwrap()) != 2 {
+		t.Fatalf("expected Ok([1 2]), got %v", err)
+	}
+
+	if err := json.Unmarshal([]byte(`{"error":"bad"}`), &r); err != nil || r.UnwrapError().Error() != "bad" {
+		t.Fatalf("expected Err(bad), got %v", err)
+	}
+
+	if err := json.Unmarshal([]byte(`{}`), &r); err == nil {
+		t.Fatal("expected error for missing fields")
+	}
+}
+
+func TestOptionJSON(t *testing.T) {
+	type payload struct {
+		Name anygo.Option[string] `json:"name"`
+		Age  anygo.Option[int]    `json:"age"`
+	}
+	b, err := json.Marshal(payload{Name: anygo.Some("x"), Age: anygo.None[int]()})
+	if err != nil || string(b) != `{"name":"x","age":null}` {
+		t.Fatalf("unexpected JSON %s (%v)", b, err)
+	}
+
+	var p payload
+	if err := json.Unmarshal([]byte(`{"name":null,"age":3}`), &p); err != nil {
+		t.Fatal(err)
+	}
+	if !p.Name.IsNone() || p.Age.UnwrapOr(0) != 3 {
+		t.Fatalf("unexpected decoded value %+v", p)
+	}
+}
@@ -0,0 +1,39 @@
+package anygo
+
+import "sync"
+
+// LazyValue is a value computed on first use and cached afterwards.
+// It is safe for concurrent use.
+type LazyValue[T any] struct {
+	get func() T
+}
+
+// Lazy returns a LazyValue that calls f the first time Get is called.
+// If f panics, every call to Get panics with the same value.
+//
+// Example:
+//
+//	client := anygo.Lazy(newClient)
+//	client.Get().Do(req) // newClient runs here, once
+func Lazy[T any](f func() T) *LazyValue[T] {
+	return &LazyValue[T]{get: sync.OnceValue(f)}
+}
+
+// LazyResult returns a LazyValue for a fallible computation. Both Ok and
+// Err Results are cached.
+//
+// Example:
+//
+//	cfg := anygo.LazyResult(loadConfig)
+//	if err := cfg.Get().UnwrapError(); err != nil {
+//		return err
+//	}
+func LazyResult[T any](f func() Result[T]) *LazyValue[Result[T]] {
+	return Lazy(f)
+}
+
+// Get returns the value, computing it on the first call. Concurrent callers
+// wait for the first computation to finish.
+func (l *LazyValue[T]) Get() T {
+	return l.get()
+}
@@ -0,0 +1,49 @@
+package anygo_test
+
+import (
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/daxartio/anygo"
+)
+
+func TestLazy(t *testing.T) {
+	var calls atomic.Int32
+	l := anygo.Lazy(func() int {
+		calls.Add(1)
+		return 42
+	})
+	if calls.Load() != 0 {
+		t.Fatal("expected computation to be deferred")
+	}
+
+	var wg sync.WaitGroup
+	for range 10 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if v := l.Get(); v != 42 {
+				t.Errorf("expected 42, got %d", v)
+			}
+		}()
+	}
+	wg.Wait()
+	if n := calls.Load(); n != 1 {
+		t.Fatalf("expected 1 call, got %d", n)
+	}
+}
+
+func TestLazyResult(t *testing.T) {
+	calls := 0
+	err := errors.New("fail")
+	l := anygo.LazyResult(func() anygo.Result[int] {
+		calls++
+		return anygo.Err[int](err)
+	})
+	l.Get()
+	if r := l.Get(); r.UnwrapError() != err || calls != 1 {
+		t.Fatal("expected cached error")
+	}
+}
@@ -0,0 +1,63 @@
+package anygo
+
+import "sync"
+
+// Locked guards a value with a mutex. The value is only reachable through
+// With and Read, so it cannot be accessed without holding the lock. The
+// zero value holds the zero value of T.
+type Locked[T any] struct {
+	mu sync.Mutex
+	v  T
+}
+
+// NewLocked returns a Locked holding v.
+//
+// Example:
+//
+//	counts := anygo.NewLocked(map[string]int{})
+//	counts.With(func(m *map[string]int) { (*m)["hits"]++ })
+func NewLocked[T any](v T) *Locked[T] {
+	return &Locked[T]{v: v}
+}
+
+// With calls f with a pointer to the value while holding the lock. f must
+// not retain the pointer after it returns.
+func (l *Locked[T]) With(f func(*T)) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	f(&l.v)
+}
+
+// Read calls f with a copy of the value while holding the lock.
+func (l *Locked[T]) Read(f func(T)) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	f(l.v)
+}
+
+// RWLocked is like Locked but guards the value with a sync.RWMutex, so
+// concurrent Read calls do not block each other.
+type RWLocked[T any] struct {
+	mu sync.RWMutex
+	v  T
+}
+
+// NewRWLocked returns an RWLocked holding v.
+func NewRWLocked[T any](v T) *RWLocked[T] {
+	return &RWLocked[T]{v: v}
+}
+
+// With calls f with a pointer to the value while holding the write lock. f
+// must not retain the pointer after it returns.
+func (l *RWLocked[T]) With(f func(*T)) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	f(&l.v)
+}
+
+// Read calls f with a copy of the value while holding the read lock.
+func (l *RWLocked[T]) Read(f func(T)) {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+	f(l.v)
+}
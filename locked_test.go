@@ -0,0 +1,51 @@
+package anygo_test
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/daxartio/anygo"
+)
+
+func TestLocked(t *testing.T) {
+	l := anygo.NewLocked(map[string]int{})
+	var wg sync.WaitGroup
+	for range 100 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			l.With(func(m *map[string]int) { (*m)["n"]++ })
+		}()
+	}
+	wg.Wait()
+	l.Read(func(m map[string]int) {
+		if m["n"] != 100 {
+			t.Fatalf("expected 100, got %d", m["n"])
+		}
+	})
+}
+
+func TestRWLocked(t *testing.T) {
+	var l anygo.RWLocked[int]
+	var wg sync.WaitGroup
+	for range 100 {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			l.With(func(n *int) { *n++ })
+		}()
+		go func() {
+			defer wg.Done()
+			l.Read(func(int) {})
+		}()
+	}
+	wg.Wait()
+	l.Read(func(n int) {
+		if n != 100 {
+			t.Fatalf("expected 100, got %d", n)
+		}
+	})
+	if anygo.NewRWLocked(5) == nil {
+		t.Fatal("expected RWLocked")
+	}
+}
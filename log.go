@@ -0,0 +1,42 @@
+package anygo
+
+import (
+	"context"
+	"log/slog"
+)
+
+// LogValue implements slog.LogValuer. An Ok Result logs as a group with
+// status=ok and value, an Err as a group with status=err and error.
+//
+// Example:
+//
+//	slog.Info("loaded", "config", r) // config.status=ok config.value=...
+func (r Result[T]) LogValue() slog.Value {
+	if r.IsErr() {
+		return slog.GroupValue(slog.String("status", "err"), slog.String("error", r.err.Error()))
+	}
+	return slog.GroupValue(slog.String("status", "ok"), slog.Any("value", r.value))
+}
+
+// LogValue implements slog.LogValuer. Some logs as a group with some=true
+// and value, None as a group with some=false.
+func (o Option[T]) LogValue() slog.Value {
+	if !o.some {
+		return slog.GroupValue(slog.Bool("some", false))
+	}
+	return slog.GroupValue(slog.Bool("some", true), slog.Any("value", o.value))
+}
+
+// LogErr logs the error through logger at error level if the Result is Err,
+// and returns the Result unchanged. args are added to the record after the
+// error, as in slog.Logger.Error.
+//
+// Example:
+//
+//	user := loadUser(id).LogErr(logger, "load user", "id", id)
+func (r Result[T]) LogErr(logger *slog.Logger, msg string, args ...any) Result[T] {
+	if r.IsErr() {
+		logger.Log(context.Background(), slog.LevelError, msg, append([]any{slog.Any("error", r.err)}, args...)...)
+	}
+	return r
+}
@@ -0,0 +1,54 @@
+package anygo_test
+
+import (
+	"bytes"
+	"errors"
+	"log/slog"
+	"strings"
+	"testing"
+
+	"github.com/daxartio/anygo"
+)
+
+func newTestLogger() (*slog.Logger, *bytes.Buffer) {
+	var buf bytes.Buffer
+	h := slog.NewTextHandler(&buf, &slog.HandlerOptions{
+		ReplaceAttr: func(_ []string, a slog.Attr) slog.Attr {
+			if a.Key == slog.TimeKey {
+				return slog.Attr{}
+			}
+			return a
+		},
+	})
+	return slog.New(h), &buf
+}
+
+func TestResultLogValue(t *testing.T) {
+	logger, buf := newTestLogger()
+	logger.Info("a", "r", anygo.Ok(1))
+	logger.Info("b", "r", anygo.Err[int](errors.New("boom")))
+	got := buf.String()
+	if !strings.Contains(got, "r.status=ok r.value=1") || !strings.Contains(got, "r.status=err r.error=boom") {
+		t.Fatalf("unexpected log output %q", got)
+	}
+}
+
+func TestOptionLogValue(t *testing.T) {
+	logger, buf := newTestLogger()
+	logger.Info("a", "o", anygo.Some("x"), "n", anygo.None[string]())
+	if got := buf.String(); !strings.Contains(got, "o.some=true o.value=x n.some=false") {
+		t.Fatalf("unexpected log output %q", got)
+	}
+}
+
+func TestLogErr(t *testing.T) {
+	logger, buf := newTestLogger()
+	anygo.Ok(1).LogErr(logger, "ok")
+	r := anygo.Err[int](errors.New("boom")).LogErr(logger, "failed", "id", 7)
+	if r.IsOk() {
+		t.Fatal("expected Result to be returned unchanged")
+	}
+	if got := buf.String(); got != "level=ERROR msg=failed error=boom id=7\n" {
+		t.Fatalf("unexpected log output %q", got)
+	}
+}
@@ -0,0 +1,101 @@
+// Package mapx provides generic map helpers.
+//
+// Iteration order over Go maps is unspecified, so the slices returned by
+// Keys, Values and Entries have no particular order.
+package mapx
+
+import "github.com/daxartio/anygo"
+
+// Keys returns the keys of m.
+func Keys[K comparable, V any](m map[K]V) []K {
+	keys := make([]K, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	return keys
+}
+
+// Values returns the values of m.
+func Values[K comparable, V any](m map[K]V) []V {
+	vals := make([]V, 0, len(m))
+	for _, v := range m {
+		vals = append(vals, v)
+	}
+	return vals
+}
+
+// Entries returns the key/value pairs of m.
+//
+// Example:
+//
+//	for _, e := range mapx.Entries(m) {
+//		fmt.Println(e.First, e.Second)
+//	}
+func Entries[K comparable, V any](m map[K]V) []anygo.Pair[K, V] {
+	entries := make([]anygo.Pair[K, V], 0, len(m))
+	for k, v := range m {
+		entries = append(entries, anygo.Pair[K, V]{First: k, Second: v})
+	}
+	return entries
+}
+
+// FromEntries builds a map from key/value pairs. When a key appears more
+// than once, the last pair wins. It is the inverse of Entries.
+func FromEntries[K comparable, V any](entries []anygo.Pair[K, V]) map[K]V {
+	out := make(map[K]V, len(entries))
+	for _, e := range entries {
+		out[e.First] = e.Second
+	}
+	return out
+}
+
+// Merge returns a new map with the entries of every map in ms. When a key
+// appears more than once, the value from the later map wins.
+//
+// Example:
+//
+//	cfg := mapx.Merge(defaults, overrides)
+func Merge[K comparable, V any](ms ...map[K]V) map[K]V {
+	n := 0
+	for _, m := range ms {
+		n += len(m)
+	}
+	out := make(map[K]V, n)
+	for _, m := range ms {
+		for k, v := range m {
+			out[k] = v
+		}
+	}
+	return out
+}
+
+// Invert returns a new map from the values of m to their keys. If several
+// keys share a value, which key is kept is unspecified.
+func Invert[K, V comparable](m map[K]V) map[V]K {
+	out := make(map[V]K, len(m))
+	for k, v := range m {
+		out[v] = k
+	}
+	return out
+}
+
+// MapValues returns a new map with f applied to each value of m.
+func MapValues[K comparable, V, U any](m map[K]V, f func(V) U) map[K]U {
+	out := make(map[K]U, len(m))
+	for k, v := range m {
+		out[k] = f(v)
+	}
+	return out
+}
+
+// Filter returns a new map with the entries of m for which pred returns
+// true.
+func Filter[K comparable, V any](m map[K]V, pred func(K, V) bool) map[K]V {
+	out := make(map[K]V)
+	for k, v := range m {
+		if pred(k, v) {
+			out[k] = v
+		}
+	}
+	return out
+}
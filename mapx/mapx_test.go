@@ -0,0 +1,70 @@
+package mapx_test
+
+import (
+	"maps"
+	"slices"
+	"testing"
+
+	"github.com/daxartio/anygo"
+	"github.com/daxartio/anygo/mapx"
+)
+
+func TestKeysValues(t *testing.T) {
+	m := map[string]int{"a": 1, "b": 2}
+	keys := mapx.Keys(m)
+	slices.Sort(keys)
+	if !slices.Equal(keys, []string{"a", "b"}) {
+		t.Fatalf("expected [a b], got %v", keys)
+	}
+	vals := mapx.Values(m)
+	slices.Sort(vals)
+	if !slices.Equal(vals, []int{1, 2}) {
+		t.Fatalf("expected [1 2], got %v", vals)
+	}
+}
+
+func TestEntries(t *testing.T) {
+	entries := mapx.Entries(map[string]int{"a": 1})
+	if len(entries) != 1 || entries[0].First != "a" || entries[0].Second != 1 {
+		t.Fatalf("unexpected entries %v", entries)
+	}
+}
+
+func TestFromEntries(t *testing.T) {
+	m := map[string]int{"a": 1, "b": 2}
+	if got := mapx.FromEntries(mapx.Entries(m)); !maps.Equal(got, m) {
+		t.Fatalf("expected round trip, got %v", got)
+	}
+	got := mapx.FromEntries([]anygo.Pair[string, int]{anygo.NewPair("a", 1), anygo.NewPair("a", 2)})
+	if got["a"] != 2 {
+		t.Fatalf("expected last pair to win, got %v", got)
+	}
+}
+
+func TestMerge(t *testing.T) {
+	got := mapx.Merge(map[string]int{"a": 1, "b": 2}, map[string]int{"b": 3, "c": 4})
+	if !maps.Equal(got, map[string]int{"a": 1, "b": 3, "c": 4}) {
+		t.Fatalf("unexpected merge %v", got)
+	}
+}
+
+func TestInvert(t *testing.T) {
+	got := mapx.Invert(map[string]int{"a": 1, "b": 2})
+	if !maps.Equal(got, map[int]string{1: "a", 2: "b"}) {
+		t.Fatalf("unexpected inverse %v", got)
+	}
+}
+
+func TestMapValues(t *testing.T) {
+	got := mapx.MapValues(map[string]int{"a": 1}, func(v int) int { return v * 10 })
+	if !maps.Equal(got, map[string]int{"a": 10}) {
+		t.Fatalf("unexpected map %v", got)
+	}
+}
+
+func TestFilter(t *testing.T) {
+	got := mapx.Filter(map[string]int{"a": 1, "b": 2}, func(_ string, v int) bool { return v > 1 })
+	if !maps.Equal(got, map[string]int{"b": 2}) {
+		t.Fatalf("unexpected map %v", got)
+	}
+}
@@ -0,0 +1,120 @@
+package anygo
+
+import (
+	"container/list"
+	"sync"
+)
+
+// Memoize returns a function that calls f at most once and returns its
+// Result on every call. Concurrent callers block until the first call
+// completes and all observe the same Result.
+//
+// Both outcomes are cached: an Err is returned again rather than retried.
+// To retry failures, wrap f in the retry logic before memoizing it. If f
+// panics, every call panics with the same value.
+//
+// Example:
+//
+//	load := anygo.Memoize(loadConfig)
+//	cfg := load() // runs loadConfig
+//	cfg = load()  // cached
+func Memoize[T any](f func() Result[T]) func() Result[T] {
+	return sync.OnceValue(f)
+}
+
+// Memo is a memoized function created by MemoizeKeyed or MemoizeResult. It is
+// safe for concurrent use.
+type Memo[K comparable, V any] struct {
+	mu      sync.Mutex
+	f       func(K) V
+	keep    func(V) bool
+	maxSize int
+	entries map[K]*memoEntry[V]
+	order   *list.List // keys in insertion order, oldest first
+}
+
+type memoEntry[V any] struct {
+	get  func() V
+	elem *list.Element
+}
+
+// MemoizeKeyed returns a Memo that calls f at most once per key and caches the
+// value. Concurrent calls with the same key wait for the first call to
+// finish and share its value.
+//
+// If maxSize is positive, the Memo holds at most maxSize keys and forgets
+// the oldest one when a new key is added. Zero or negative means no limit.
+//
+// Example:
+//
+//	fib := anygo.MemoizeKeyed(slowFib, 1000)
+//	fib.Get(40) // computed
+//	fib.Get(40) // cached
+func MemoizeKeyed[K comparable, V any](f func(K) V, maxSize int) *Memo[K, V] {
+	return &Memo[K, V]{
+		f:       f,
+		maxSize: maxSize,
+		entries: make(map[K]*memoEntry[V]),
+		order:   list.New(),
+	}
+}
+
+// MemoizeResult is like MemoizeKeyed for fallible functions. Only Ok Results are
+// cached; an Err is returned to the callers that were waiting for it and the
+// next call retries f.
+//
+// Example:
+//
+//	user := anygo.MemoizeResult(loadUser, 0)
+//	u := user.Get(id).UnwrapOr(guest)
+func MemoizeResult[K comparable, V any](f func(K) Result[V], maxSize int) *Memo[K, Result[V]] {
+	m := MemoizeKeyed(f, maxSize)
+	m.keep = Result[V].IsOk
+	return m
+}
+
+// Get returns the value of f for k, calling f only if k is not cached.
+func (m *Memo[K, V]) Get(k K) V {
+	m.mu.Lock()
+	e, ok := m.entries[k]
+	if !ok {
+		e = &memoEntry[V]{get: sync.OnceValue(func() V { return m.f(k) })}
+		e.elem = m.order.PushBack(k)
+		m.entries[k] = e
+		if m.maxSize > 0 && m.order.Len() > m.maxSize {
+			m.remove(m.order.Front().Value.(K))
+		}
+	}
+	m.mu.Unlock()
+
+	v := e.get()
+	if m.keep != nil && !m.keep(v) {
+		m.mu.Lock()
+		if m.entries[k] == e {
+			m.remove(k)
+		}
+		m.mu.Unlock()
+	}
+	return v
+}
+
+// Forget removes k from the cache so that the next Get calls f again.
+func (m *Memo[K, V]) Forget(k K) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if _, ok := m.entries[k]; ok {
+		m.remove(k)
+	}
+}
+
+// Len returns the number of cached keys.
+func (m *Memo[K, V]) Len() int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return len(m.entries)
+}
+
+func (m *Memo[K, V]) remove(k K) {
+	m.order.Remove(m.entries[k].elem)
+	delete(m.entries, k)
+}
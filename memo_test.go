@@ -0,0 +1,149 @@
+package anygo_test
+
+import (
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/daxartio/anygo"
+)
+
+func TestMemoize(t *testing.T) {
+	calls := 0
+	f := anygo.Memoize(func() anygo.Result[int] {
+		calls++
+		return anygo.Ok(calls)
+	})
+
+	var wg sync.WaitGroup
+	for range 10 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if v := f().MustUnwrap(); v != 1 {
+				t.Errorf("expected 1, got %d", v)
+			}
+		}()
+	}
+	wg.Wait()
+	if calls != 1 {
+		t.Fatalf("expected 1 call, got %d", calls)
+	}
+}
+
+func TestMemoizeCachesErr(t *testing.T) {
+	calls := 0
+	err := errors.New("fail")
+	f := anygo.Memoize(func() anygo.Result[int] {
+		calls++
+		return anygo.Err[int](err)
+	})
+	f()
+	if r := f(); r.UnwrapError() != err || calls != 1 {
+		t.Fatal("expected cached error without retry")
+	}
+}
+
+func TestMemoizePanicRepeats(t *testing.T) {
+	calls := 0
+	f := anygo.Memoize(func() anygo.Result[int] {
+		calls++
+		panic("boom")
+	})
+	call := func() (p any) {
+		defer func() { p = recover() }()
+		f()
+		return nil
+	}
+	for i := range 2 {
+		if p := call(); p != "boom" {
+			t.Fatalf("call %d: expected the panic to repeat instead of a Result, got %v", i+1, p)
+		}
+	}
+	if calls != 1 {
+		t.Fatalf("expected 1 call, got %d", calls)
+	}
+}
+
+func TestMemoizeKeyed(t *testing.T) {
+	var calls atomic.Int32
+	m := anygo.MemoizeKeyed(func(k int) int {
+		calls.Add(1)
+		return k * 2
+	}, 0)
+
+	var wg sync.WaitGroup
+	for range 10 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if v := m.Get(21); v != 42 {
+				t.Errorf("expected 42, got %d", v)
+			}
+		}()
+	}
+	wg.Wait()
+	if calls.Load() != 1 {
+		t.Fatalf("expected 1 call, got %d", calls.Load())
+	}
+	m.Get(1)
+	if calls.Load() != 2 || m.Len() != 2 {
+		t.Fatalf("expected a call per key, got %d calls and %d keys", calls.Load(), m.Len())
+	}
+}
+
+func TestMemoizeKeyedForget(t *testing.T) {
+	calls := 0
+	m := anygo.MemoizeKeyed(func(k string) int {
+		calls++
+		return len(k)
+	}, 0)
+	m.Get("abc")
+	m.Forget("abc")
+	m.Forget("missing")
+	m.Get("abc")
+	if calls != 2 {
+		t.Fatalf("expected Forget to force a second call, got %d", calls)
+	}
+}
+
+func TestMemoizeKeyedMaxSize(t *testing.T) {
+	calls := 0
+	m := anygo.MemoizeKeyed(func(k int) int {
+		calls++
+		return k
+	}, 2)
+	m.Get(1)
+	m.Get(2)
+	m.Get(3)
+	if m.Len() != 2 {
+		t.Fatalf("expected 2 keys, got %d", m.Len())
+	}
+	m.Get(1)
+	if calls != 4 {
+		t.Fatalf("expected oldest key to be forgotten, got %d calls", calls)
+	}
+}
+
+func TestMemoizeResultRetriesErr(t *testing.T) {
+	calls := 0
+	err := errors.New("fail")
+	m := anygo.MemoizeResult(func(k int) anygo.Result[int] {
+		calls++
+		if calls == 1 {
+			return anygo.Err[int](err)
+		}
+		return anygo.Ok(k)
+	}, 0)
+	if r := m.Get(7); r.UnwrapError() != err {
+		t.Fatalf("expected first call to fail, got %v", r)
+	}
+	if r := m.Get(7); r.MustUnwrap() != 7 {
+		t.Fatalf("expected retry to succeed, got %v", r)
+	}
+	m.Get(7)
+	if calls != 2 {
+		t.Fatalf("expected Ok to be cached, got %d calls", calls)
+	}
+}
@@ -0,0 +1,34 @@
+package anygo
+
+// Must returns v, or panics with err if it is not nil. Like MustUnwrap, it
+// is meant for tests and initialization code.
+//
+// Example:
+//
+//	var re = anygo.Must(regexp.Compile(`^\d+$`))
+func Must[T any](v T, err error) T {
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Must2 is like Must for functions returning two values and an error.
+//
+// Example:
+//
+//	host, port := anygo.Must2(splitHostPort(addr))
+func Must2[A, B any](a A, b B, err error) (A, B) {
+	if err != nil {
+		panic(err)
+	}
+	return a, b
+}
+
+// Must3 is like Must for functions returning three values and an error.
+func Must3[A, B, C any](a A, b B, c C, err error) (A, B, C) {
+	if err != nil {
+		panic(err)
+	}
+	return a, b, c
+}
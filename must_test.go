@@ -0,0 +1,33 @@
+package anygo_test
+
+import (
+	"errors"
+	"strconv"
+	"testing"
+
+	"github.com/daxartio/anygo"
+)
+
+func TestMust(t *testing.T) {
+	if anygo.Must(strconv.Atoi("42")) != 42 {
+		t.Fatal("expected 42")
+	}
+	a, b := anygo.Must2(1, "x", nil)
+	if a != 1 || b != "x" {
+		t.Fatal("unexpected Must2 values")
+	}
+	x, y, z := anygo.Must3(1, 2, 3, nil)
+	if x+y+z != 6 {
+		t.Fatal("unexpected Must3 values")
+	}
+}
+
+func TestMustPanics(t *testing.T) {
+	err := errors.New("boom")
+	defer func() {
+		if p := recover(); p != err {
+			t.Fatalf("expected panic with err, got %v", p)
+		}
+	}()
+	anygo.Must2(1, 2, err)
+}
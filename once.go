@@ -0,0 +1,39 @@
+package anygo
+
+import "sync"
+
+// Once returns a function that calls f on its first call and returns the
+// resulting Result on every call. Both Ok and Err are cached. Concurrent
+// callers wait for the first call to finish.
+//
+// Example:
+//
+//	getClient := anygo.Once(dialClient)
+//	c, err := getClient().Unwrap()
+func Once[T any](f func() (T, error)) func() Result[T] {
+	return sync.OnceValue(func() Result[T] { return Try(f()) })
+}
+
+// OnceRetry is like Once, but only an Ok Result is cached: after an Err the
+// next call runs f again. Calls are serialized until f succeeds.
+//
+// Example:
+//
+//	getClient := anygo.OnceRetry(dialClient)
+//	c := getClient() // retried on the next call if dialing failed
+func OnceRetry[T any](f func() (T, error)) func() Result[T] {
+	var (
+		mu   sync.Mutex
+		done bool
+		r    Result[T]
+	)
+	return func() Result[T] {
+		mu.Lock()
+		defer mu.Unlock()
+		if !done {
+			r = Try(f())
+			done = r.IsOk()
+		}
+		return r
+	}
+}
@@ -0,0 +1,53 @@
+package anygo_test
+
+import (
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/daxartio/anygo"
+)
+
+func TestOnce(t *testing.T) {
+	calls := 0
+	err := errors.New("fail")
+	get := anygo.Once(func() (int, error) {
+		calls++
+		return 0, err
+	})
+	var wg sync.WaitGroup
+	for range 10 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if r := get(); r.UnwrapError() != err {
+				t.Errorf("expected cached error, got %v", r)
+			}
+		}()
+	}
+	wg.Wait()
+	if calls != 1 {
+		t.Fatalf("expected 1 call, got %d", calls)
+	}
+}
+
+func TestOnceRetry(t *testing.T) {
+	calls := 0
+	get := anygo.OnceRetry(func() (int, error) {
+		calls++
+		if calls < 3 {
+			return 0, errors.New("not yet")
+		}
+		return calls, nil
+	})
+	get()
+	if get().IsOk() {
+		t.Fatal("expected second call to fail")
+	}
+	if v := get().MustUnwrap(); v != 3 {
+		t.Fatalf("expected 3, got %d", v)
+	}
+	if v := get().MustUnwrap(); v != 3 || calls != 3 {
+		t.Fatalf("expected cached 3 after 3 calls, got %d after %d", v, calls)
+	}
+}
@@ -0,0 +1,187 @@
+package anygo
+
+// Option represents an optional value of type T: either Some value or None.
+type Option[T any] struct {
+	value T
+	some  bool
+}
+
+// Some returns an Option containing value.
+//
+// Example:
+//
+//	o := anygo.Some(5)
+//	fmt.Println(o.IsSome()) // true
+func Some[T any](val T) Option[T] {
+	return Option[T]{value: val, some: true}
+}
+
+// None returns an empty Option.
+//
+// Example:
+//
+//	o := anygo.None[int]()
+//	fmt.Println(o.IsNone()) // true
+func None[T any]() Option[T] {
+	return Option[T]{}
+}
+
+// IsSome returns true if the Option contains a value.
+func (o Option[T]) IsSome() bool {
+	return o.some
+}
+
+// IsNone returns true if the Option is empty.
+func (o Option[T]) IsNone() bool {
+	return !o.some
+}
+
+// Unwrap returns the value and whether it is present.
+//
+// Example:
+//
+//	v, ok := anygo.Some("hi").Unwrap()
+//	fmt.Println(v, ok) // "hi", true
+func (o Option[T]) Unwrap() (T, bool) {
+	return o.value, o.some
+}
+
+// UnwrapOr returns the value if present, or the default otherwise.
+//
+// Example:
+//
+//	fmt.Println(anygo.None[int]().UnwrapOr(7)) // 7
+func (o Option[T]) UnwrapOr(def T) T {
+	if o.some {
+		return o.value
+	}
+	return def
+}
+
+// Map applies a function to the value if present.
+//
+// Example:
+//
+//	o := anygo.Some(2).Map(func(x int) int { return x * x })
+func (o Option[T]) Map(f func(T) T) Option[T] {
+	if o.IsNone() {
+		return o
+	}
+	return Some(f(o.value))
+}
+
+// MapOption applies a function to the value if present, changing its type.
+//
+// Example:
+//
+//	o := anygo.MapOption(anygo.Some(2), strconv.Itoa)
+//	fmt.Println(o.UnwrapOr("")) // "2"
+func MapOption[T any, U any](o Option[T], f func(T) U) Option[U] {
+	if o.IsNone() {
+		return None[U]()
+	}
+	return Some(f(o.value))
+}
+
+// Filter returns the Option if it is present and pred returns true for the
+// value, or None otherwise.
+func (o Option[T]) Filter(pred func(T) bool) Option[T] {
+	if o.IsSome() && pred(o.value) {
+		return o
+	}
+	return None[T]()
+}
+
+// OkOr converts the Option into a Result: Ok with the value if present, or
+// Err with err otherwise.
+//
+// Example:
+//
+//	r := anygo.None[int]().OkOr(errors.New("missing"))
+//	fmt.Println(r.IsErr()) // true
+func (o Option[T]) OkOr(err error) Result[T] {
+	if o.IsNone() {
+		return Err[T](err)
+	}
+	return Ok(o.value)
+}
+
+// Ok converts the Result into an Option: Some with the value if Ok, or None
+// if Err. The error is discarded.
+func (r Result[T]) Ok() Option[T] {
+	if r.IsErr() {
+		return None[T]()
+	}
+	return Some(r.value)
+}
+
+// Transpose converts a Result of an Option into an Option of a Result.
+// Ok(None) becomes None, Ok(Some(v)) becomes Some(Ok(v)) and Err(e) becomes
+// Some(Err(e)).
+//
+// Example:
+//
+//	o := anygo.Transpose(anygo.Ok(anygo.Some(1)))
+//	fmt.Println(o.IsSome()) // true
+func Transpose[T any](r Result[Option[T]]) Option[Result[T]] {
+	if r.IsErr() {
+		return Some(Err[T](r.err))
+	}
+	if r.value.IsNone() {
+		return None[Result[T]]()
+	}
+	return Some(Ok(r.value.value))
+}
+
+// TransposeOption converts an Option of a Result into a Result of an Option.
+// It is the inverse of Transpose: None becomes Ok(None), Some(Ok(v)) becomes
+// Ok(Some(v)) and Some(Err(e)) becomes Err(e).
+func TransposeOption[T any](o Option[Result[T]]) Result[Option[T]] {
+	if o.IsNone() {
+		return Ok(None[T]())
+	}
+	if o.value.IsErr() {
+		return Err[Option[T]](o.value.err)
+	}
+	return Ok(Some(o.value.value))
+}
+
+// FlattenToOption returns the inner Option if r is Ok, or None if r is Err.
+// The error is discarded; use Transpose to keep it.
+//
+// Example:
+//
+//	o := anygo.FlattenToOption(anygo.Err[anygo.Option[int]](err))
+//	fmt.Println(o.IsNone()) // true
+func FlattenToOption[T any](r Result[Option[T]]) Option[T] {
+	if r.IsErr() {
+		return None[T]()
+	}
+	return r.value
+}
+
+// FlattenOption removes one level of nesting: Some(Some(v)) becomes Some(v),
+// and None at either level becomes None.
+func FlattenOption[T any](o Option[Option[T]]) Option[T] {
+	if !o.some {
+		return None[T]()
+	}
+	return o.value
+}
+
+// FromTriple converts a (value, found, error) triple into a Result of an
+// Option: Err if err is not nil, Ok(Some(val)) if found, and Ok(None)
+// otherwise.
+//
+// Example:
+//
+//	r := anygo.FromTriple(cache.Get(key))
+func FromTriple[T any](val T, found bool, err error) Result[Option[T]] {
+	if err != nil {
+		return Err[Option[T]](err)
+	}
+	if !found {
+		return Ok(None[T]())
+	}
+	return Ok(Some(val))
+}
@@ -0,0 +1,156 @@
+package anygo_test
+
+import (
+	"errors"
+	"strconv"
+	"testing"
+
+	"github.com/daxartio/anygo"
+)
+
+func TestSome(t *testing.T) {
+	o := anygo.Some(42)
+	if !o.IsSome() || o.IsNone() {
+		t.Fatal("expected Some option")
+	}
+	if v, ok := o.Unwrap(); !ok || v != 42 {
+		t.Fatalf("expected 42, got %d", v)
+	}
+}
+
+func TestNone(t *testing.T) {
+	o := anygo.None[int]()
+	if !o.IsNone() || o.IsSome() {
+		t.Fatal("expected None option")
+	}
+	if _, ok := o.Unwrap(); ok {
+		t.Fatal("expected no value")
+	}
+}
+
+func TestOptionUnwrapOr(t *testing.T) {
+	if v := anygo.Some(1).UnwrapOr(7); v != 1 {
+		t.Fatalf("expected 1, got %d", v)
+	}
+	if v := anygo.None[int]().UnwrapOr(7); v != 7 {
+		t.Fatalf("expected 7, got %d", v)
+	}
+}
+
+func TestOptionMap(t *testing.T) {
+	if v := anygo.Some(2).Map(func(i int) int { return i * i }).UnwrapOr(0); v != 4 {
+		t.Fatalf("expected 4, got %d", v)
+	}
+	if o := anygo.None[int]().Map(func(i int) int { return i * i }); !o.IsNone() {
+		t.Fatal("expected None")
+	}
+}
+
+func TestMapOption(t *testing.T) {
+	if v := anygo.MapOption(anygo.Some(2), strconv.Itoa).UnwrapOr(""); v != "2" {
+		t.Fatalf("expected '2', got %q", v)
+	}
+	if o := anygo.MapOption(anygo.None[int](), strconv.Itoa); !o.IsNone() {
+		t.Fatal("expected None")
+	}
+}
+
+func TestOptionFilter(t *testing.T) {
+	even := func(i int) bool { return i%2 == 0 }
+	if o := anygo.Some(2).Filter(even); !o.IsSome() {
+		t.Fatal("expected Some")
+	}
+	if o := anygo.Some(3).Filter(even); !o.IsNone() {
+		t.Fatal("expected None")
+	}
+}
+
+func TestOkOr(t *testing.T) {
+	if v := anygo.Some(1).OkOr(errors.New("missing")).MustUnwrap(); v != 1 {
+		t.Fatalf("expected 1, got %d", v)
+	}
+	err := errors.New("missing")
+	if r := anygo.None[int]().OkOr(err); r.UnwrapError() != err {
+		t.Fatal("expected Err")
+	}
+}
+
+func TestResultOk(t *testing.T) {
+	if v, ok := anygo.Ok(1).Ok().Unwrap(); !ok || v != 1 {
+		t.Fatal("expected Some(1)")
+	}
+	if o := anygo.Err[int](errors.New("fail")).Ok(); !o.IsNone() {
+		t.Fatal("expected None")
+	}
+}
+
+func TestTranspose(t *testing.T) {
+	if o := anygo.Transpose(anygo.Ok(anygo.None[int]())); !o.IsNone() {
+		t.Fatal("expected Ok(None) to become None")
+	}
+
+	o := anygo.Transpose(anygo.Ok(anygo.Some(3)))
+	r, ok := o.Unwrap()
+	if !ok || r.MustUnwrap() != 3 {
+		t.Fatal("expected Ok(Some(3)) to become Some(Ok(3))")
+	}
+
+	err := errors.New("network")
+	o = anygo.Transpose(anygo.Err[anygo.Option[int]](err))
+	r, ok = o.Unwrap()
+	if !ok || r.UnwrapError() != err {
+		t.Fatal("expected Err to become Some(Err)")
+	}
+}
+
+func TestTransposeOption(t *testing.T) {
+	r := anygo.TransposeOption(anygo.None[anygo.Result[int]]())
+	if o := r.MustUnwrap(); !o.IsNone() {
+		t.Fatal("expected None to become Ok(None)")
+	}
+
+	r = anygo.TransposeOption(anygo.Some(anygo.Ok(3)))
+	if v, ok := r.MustUnwrap().Unwrap(); !ok || v != 3 {
+		t.Fatal("expected Some(Ok(3)) to become Ok(Some(3))")
+	}
+
+	err := errors.New("network")
+	r = anygo.TransposeOption(anygo.Some(anygo.Err[int](err)))
+	if r.UnwrapError() != err {
+		t.Fatal("expected Some(Err) to become Err")
+	}
+}
+
+func TestFlattenToOption(t *testing.T) {
+	if v, ok := anygo.FlattenToOption(anygo.Ok(anygo.Some(1))).Unwrap(); !ok || v != 1 {
+		t.Fatal("expected Some(1)")
+	}
+	if o := anygo.FlattenToOption(anygo.Ok(anygo.None[int]())); !o.IsNone() {
+		t.Fatal("expected None")
+	}
+	if o := anygo.FlattenToOption(anygo.Err[anygo.Option[int]](errors.New("fail"))); !o.IsNone() {
+		t.Fatal("expected Err to become None")
+	}
+}
+
+func TestFlattenOption(t *testing.T) {
+	if v, ok := anygo.FlattenOption(anygo.Some(anygo.Some(1))).Unwrap(); !ok || v != 1 {
+		t.Fatal("expected Some(1)")
+	}
+	if !anygo.FlattenOption(anygo.Some(anygo.None[int]())).IsNone() || !anygo.FlattenOption(anygo.None[anygo.Option[int]]()).IsNone() {
+		t.Fatal("expected None")
+	}
+}
+
+func TestFromTriple(t *testing.T) {
+	if v, ok := anygo.FromTriple(1, true, nil).MustUnwrap().Unwrap(); !ok || v != 1 {
+		t.Fatal("expected Ok(Some(1))")
+	}
+	if o := anygo.FromTriple(1, false, nil).MustUnwrap(); !o.IsNone() {
+		t.Fatal("expected Ok(None)")
+	}
+	err := errors.New("fail")
+	if r := anygo.FromTriple(1, true, err); r.UnwrapError() != err {
+		t.Fatal("expected Err")
+	}
+}
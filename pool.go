@@ -0,0 +1,46 @@
+package anygo
+
+import "sync"
+
+// Pool is a typed wrapper around sync.Pool. Like sync.Pool, a Pool must not
+// be copied after first use and is safe for concurrent use.
+//
+// Example:
+//
+//	buffers := anygo.Pool[*bytes.Buffer]{
+//		New:   func() *bytes.Buffer { return new(bytes.Buffer) },
+//		Reset: (*bytes.Buffer).Reset,
+//	}
+//	buf := buffers.Get()
+//	defer buffers.Put(buf)
+type Pool[T any] struct {
+	// New creates a value when the pool is empty. If nil, Get returns the
+	// zero value of T in that case.
+	New func() T
+	// Reset, if set, is called on every value passed to Put before it is
+	// returned to the pool.
+	Reset func(T)
+
+	pool sync.Pool
+}
+
+// Get returns a value from the pool, or a new one from New if the pool is
+// empty.
+func (p *Pool[T]) Get() T {
+	if v, ok := p.pool.Get().(T); ok {
+		return v
+	}
+	if p.New != nil {
+		return p.New()
+	}
+	var zero T
+	return zero
+}
+
+// Put resets v and returns it to the pool.
+func (p *Pool[T]) Put(v T) {
+	if p.Reset != nil {
+		p.Reset(v)
+	}
+	p.pool.Put(v)
+}
@@ -0,0 +1,38 @@
+package anygo_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/daxartio/anygo"
+)
+
+func TestPool(t *testing.T) {
+	created := 0
+	p := anygo.Pool[*bytes.Buffer]{
+		New: func() *bytes.Buffer {
+			created++
+			return new(bytes.Buffer)
+		},
+		Reset: (*bytes.Buffer).Reset,
+	}
+	buf := p.Get()
+	if buf == nil || created != 1 {
+		t.Fatal("expected Get to create a buffer via New")
+	}
+	buf.WriteString("data")
+	p.Put(buf)
+	if buf.Len() != 0 {
+		t.Fatal("expected Put to reset the buffer")
+	}
+	if got := p.Get(); got.Len() != 0 {
+		t.Fatal("expected an empty buffer from the pool")
+	}
+}
+
+func TestPoolWithoutNew(t *testing.T) {
+	var p anygo.Pool[*bytes.Buffer]
+	if p.Get() != nil {
+		t.Fatal("expected zero value from an empty pool without New")
+	}
+}
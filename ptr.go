@@ -0,0 +1,49 @@
+package anygo
+
+// Ptr returns a pointer to a copy of v. It is handy for filling
+// pointer-typed optional fields.
+//
+// Example:
+//
+//	req := api.UpdateUser{Name: anygo.Ptr("alice")}
+func Ptr[T any](v T) *T {
+	return &v
+}
+
+// FromPtr returns the value p points to, or def if p is nil.
+//
+// Example:
+//
+//	limit := anygo.FromPtr(req.Limit, 100)
+func FromPtr[T any](p *T, def T) T {
+	if p == nil {
+		return def
+	}
+	return *p
+}
+
+// Coalesce returns the first non-nil pointer, or nil if all are nil.
+func Coalesce[T any](ptrs ...*T) *T {
+	for _, p := range ptrs {
+		if p != nil {
+			return p
+		}
+	}
+	return nil
+}
+
+// FirstNonZero returns the first value that is not the zero value of T, or
+// the zero value if all are zero.
+//
+// Example:
+//
+//	addr := anygo.FirstNonZero(flagAddr, os.Getenv("ADDR"), ":8080")
+func FirstNonZero[T comparable](vals ...T) T {
+	var zero T
+	for _, v := range vals {
+		if v != zero {
+			return v
+		}
+	}
+	return zero
+}
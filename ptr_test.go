@@ -0,0 +1,41 @@
+package anygo_test
+
+import (
+	"testing"
+
+	"github.com/daxartio/anygo"
+)
+
+func TestPtr(t *testing.T) {
+	v := 1
+	p := anygo.Ptr(v)
+	*p = 2
+	if v != 1 || *p != 2 {
+		t.Fatal("expected Ptr to point to a copy")
+	}
+}
+
+func TestFromPtr(t *testing.T) {
+	if anygo.FromPtr(anygo.Ptr(5), 1) != 5 || anygo.FromPtr[int](nil, 1) != 1 {
+		t.Fatal("unexpected FromPtr result")
+	}
+}
+
+func TestCoalesce(t *testing.T) {
+	a, b := anygo.Ptr("a"), anygo.Ptr("b")
+	if anygo.Coalesce(nil, a, b) != a {
+		t.Fatal("expected first non-nil pointer")
+	}
+	if anygo.Coalesce[int](nil, nil) != nil || anygo.Coalesce[int]() != nil {
+		t.Fatal("expected nil")
+	}
+}
+
+func TestFirstNonZero(t *testing.T) {
+	if anygo.FirstNonZero("", "x", "y") != "x" {
+		t.Fatal("expected x")
+	}
+	if anygo.FirstNonZero(0, 0) != 0 {
+		t.Fatal("expected zero")
+	}
+}
@@ -0,0 +1,163 @@
+package anygo
+
+import "fmt"
+
+// ResultE represents a value of type T or an error of the concrete type E.
+type ResultE[T any, E error] struct {
+	value T
+	err   E
+	isErr bool
+}
+
+// OkE returns a successful ResultE containing value.
+//
+// Example:
+//
+//	r := anygo.OkE[*ParseError](42)
+//	fmt.Println(r.IsOk()) // true
+func OkE[E error, T any](val T) ResultE[T, E] {
+	return ResultE[T, E]{value: val}
+}
+
+// ErrE returns a failed ResultE containing err.
+//
+// Example:
+//
+//	r := anygo.ErrE[int](&ParseError{Line: 3})
+//	fmt.Println(r.UnwrapError().Line) // 3
+func ErrE[T any, E error](err E) ResultE[T, E] {
+	return ResultE[T, E]{err: err, isErr: true}
+}
+
+// IsOk returns true if the ResultE has no error.
+func (r ResultE[T, E]) IsOk() bool {
+	return !r.isErr
+}
+
+// IsErr returns true if the ResultE has an error.
+func (r ResultE[T, E]) IsErr() bool {
+	return r.isErr
+}
+
+// Unwrap returns the value and the error. The error is the zero value of E
+// if ok.
+func (r ResultE[T, E]) Unwrap() (T, E) {
+	return r.value, r.err
+}
+
+// UnwrapError returns the error if present, or the zero value of E if ok.
+func (r ResultE[T, E]) UnwrapError() E {
+	return r.err
+}
+
+// UnwrapOr returns the value if ok, or the default otherwise.
+func (r ResultE[T, E]) UnwrapOr(def T) T {
+	if r.IsOk() {
+		return r.value
+	}
+	return def
+}
+
+// UnwrapOrElse returns the value if ok, or calls the fallback function otherwise.
+func (r ResultE[T, E]) UnwrapOrElse(f func(E) T) T {
+	if r.IsOk() {
+		return r.value
+	}
+	return f(r.err)
+}
+
+// MustUnwrap returns the value or panics if there's an error.
+func (r ResultE[T, E]) MustUnwrap() T {
+	if r.IsErr() {
+		panic(r.err)
+	}
+	return r.value
+}
+
+// Expect panics with the provided message if ResultE is Err.
+func (r ResultE[T, E]) Expect(msg string) T {
+	if r.IsErr() {
+		panic(fmt.Sprintf("%s: %v", msg, r.err))
+	}
+	return r.value
+}
+
+// Map applies a function to the value if ok, propagates error otherwise.
+func (r ResultE[T, E]) Map(f func(T) T) ResultE[T, E] {
+	if r.IsErr() {
+		return r
+	}
+	return OkE[E](f(r.value))
+}
+
+// MapErr transforms the error if present.
+func (r ResultE[T, E]) MapErr(f func(E) E) ResultE[T, E] {
+	if r.IsOk() {
+		return r
+	}
+	return ErrE[T](f(r.err))
+}
+
+// Inspect calls a function on the value if ResultE is Ok.
+func (r ResultE[T, E]) Inspect(f func(T)) ResultE[T, E] {
+	if r.IsOk() {
+		f(r.value)
+	}
+	return r
+}
+
+// ToPtr returns a pointer to the value if Ok, or nil if Err.
+func (r ResultE[T, E]) ToPtr() *T {
+	if r.IsOk() {
+		return &r.value
+	}
+	return nil
+}
+
+// Or returns self if Ok, otherwise returns the alternative.
+func (r ResultE[T, E]) Or(other ResultE[T, E]) ResultE[T, E] {
+	if r.IsOk() {
+		return r
+	}
+	return other
+}
+
+// OrElse calls the fallback function with the error if Err.
+func (r ResultE[T, E]) OrElse(f func(E) ResultE[T, E]) ResultE[T, E] {
+	if r.IsOk() {
+		return r
+	}
+	return f(r.err)
+}
+
+// Result converts the ResultE into a Result with a plain error.
+//
+// Example:
+//
+//	var r anygo.Result[int] = anygo.ErrE[int](&ParseError{}).Result()
+func (r ResultE[T, E]) Result() Result[T] {
+	if r.IsErr() {
+		return Err[T](r.err)
+	}
+	return Ok(r.value)
+}
+
+// MapE applies a function to the value if ok, propagates error otherwise.
+//
+// Example:
+//
+//	r := anygo.MapE(anygo.OkE[*ParseError](2), strconv.Itoa)
+func MapE[T any, U any, E error](r ResultE[T, E], f func(T) U) ResultE[U, E] {
+	if r.IsErr() {
+		return ErrE[U](r.err)
+	}
+	return OkE[E](f(r.value))
+}
+
+// AndThenE chains another ResultE-producing function on success.
+func AndThenE[T any, U any, E error](r ResultE[T, E], f func(T) ResultE[U, E]) ResultE[U, E] {
+	if r.IsErr() {
+		return ErrE[U](r.err)
+	}
+	return f(r.value)
+}
@@ -0,0 +1,93 @@
+package anygo_test
+
+import (
+	"errors"
+	"strconv"
+	"testing"
+
+	"github.com/daxartio/anygo"
+)
+
+type lineError struct {
+	Line int
+}
+
+func (e *lineError) Error() string {
+	return "line " + strconv.Itoa(e.Line)
+}
+
+func TestOkE(t *testing.T) {
+	r := anygo.OkE[*lineError](42)
+	if !r.IsOk() || r.IsErr() {
+		t.Fatal("expected Ok result")
+	}
+	if v := r.MustUnwrap(); v != 42 {
+		t.Fatalf("expected 42, got %d", v)
+	}
+}
+
+func TestErrE(t *testing.T) {
+	r := anygo.ErrE[int](&lineError{Line: 3})
+	if !r.IsErr() || r.IsOk() {
+		t.Fatal("expected Err result")
+	}
+	if line := r.UnwrapError().Line; line != 3 {
+		t.Fatalf("expected typed error with line 3, got %d", line)
+	}
+	if v := r.UnwrapOr(7); v != 7 {
+		t.Fatalf("expected fallback value, got %d", v)
+	}
+}
+
+func TestResultEZeroValueIsOk(t *testing.T) {
+	var r anygo.ResultE[int, *lineError]
+	if !r.IsOk() {
+		t.Fatal("expected zero value to be Ok")
+	}
+}
+
+func TestResultEMapErr(t *testing.T) {
+	r := anygo.ErrE[int](&lineError{Line: 1}).MapErr(func(e *lineError) *lineError {
+		return &lineError{Line: e.Line + 1}
+	})
+	if line := r.UnwrapError().Line; line != 2 {
+		t.Fatalf("expected line 2, got %d", line)
+	}
+}
+
+func TestResultEOrElse(t *testing.T) {
+	r := anygo.ErrE[int](&lineError{Line: 5}).OrElse(func(e *lineError) anygo.ResultE[int, *lineError] {
+		return anygo.OkE[*lineError](e.Line)
+	})
+	if v := r.MustUnwrap(); v != 5 {
+		t.Fatalf("expected 5, got %d", v)
+	}
+}
+
+func TestResultEResult(t *testing.T) {
+	e := &lineError{Line: 9}
+	r := anygo.ErrE[int](e).Result()
+	var target *lineError
+	if !errors.As(r.UnwrapError(), &target) || target != e {
+		t.Fatal("expected typed error in plain Result")
+	}
+	if v := anygo.OkE[*lineError](1).Result().MustUnwrap(); v != 1 {
+		t.Fatal("expected Ok to convert")
+	}
+}
+
+func TestMapE(t *testing.T) {
+	r := anygo.MapE(anygo.OkE[*lineError](2), strconv.Itoa)
+	if v := r.MustUnwrap(); v != "2" {
+		t.Fatalf("expected '2', got %q", v)
+	}
+}
+
+func TestAndThenE(t *testing.T) {
+	r := anygo.AndThenE(anygo.OkE[*lineError](2), func(i int) anygo.ResultE[string, *lineError] {
+		return anygo.ErrE[string](&lineError{Line: i})
+	})
+	if line := r.UnwrapError().Line; line != 2 {
+		t.Fatalf("expected line 2, got %d", line)
+	}
+}
@@ -0,0 +1,86 @@
+package anygo
+
+import (
+	"context"
+	"errors"
+	"math/rand/v2"
+	"time"
+)
+
+// RetryPolicy configures Retry.
+type RetryPolicy struct {
+	// MaxAttempts is the total number of calls, including the first one.
+	// Values below 1 mean a single attempt.
+	MaxAttempts int
+	// InitialDelay is the wait before the second attempt.
+	InitialDelay time.Duration
+	// MaxDelay caps the wait between attempts. Zero means no cap.
+	MaxDelay time.Duration
+	// Multiplier scales the delay after each attempt. Values below 1 keep
+	// the delay constant.
+	Multiplier float64
+	// Jitter randomizes each delay by up to this fraction in either
+	// direction, e.g. 0.2 for ±20%.
+	Jitter float64
+	// Retryable reports whether an error should be retried. If nil, every
+	// error is retried.
+	Retryable func(error) bool
+}
+
+// Retry calls f until it returns Ok, the policy's attempts are exhausted, or
+// the error is not retryable, waiting between attempts with exponential
+// backoff. It returns the last Result. If ctx is done while waiting, Retry
+// returns an Err joining ctx.Err() with the last error.
+//
+// Example:
+//
+//	r := anygo.Retry(ctx, anygo.RetryPolicy{
+//		MaxAttempts:  5,
+//		InitialDelay: 100 * time.Millisecond,
+//		Multiplier:   2,
+//		Jitter:       0.2,
+//	}, fetch)
+func Retry[T any](ctx context.Context, policy RetryPolicy, f func() Result[T]) Result[T] {
+	if err := ctx.Err(); err != nil {
+		return Err[T](err)
+	}
+
+	delay := policy.InitialDelay
+	for attempt := 1; ; attempt++ {
+		r := f()
+		if r.IsOk() || attempt >= policy.MaxAttempts {
+			return r
+		}
+		if policy.Retryable != nil && !policy.Retryable(r.err) {
+			return r
+		}
+
+		timer := time.NewTimer(policy.jitter(delay))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return Err[T](errors.Join(ctx.Err(), r.err))
+		case <-timer.C:
+		}
+		delay = policy.next(delay)
+	}
+}
+
+// next returns the delay to use after d.
+func (p RetryPolicy) next(d time.Duration) time.Duration {
+	if p.Multiplier > 1 {
+		d = time.Duration(float64(d) * p.Multiplier)
+	}
+	if p.MaxDelay > 0 && d > p.MaxDelay {
+		d = p.MaxDelay
+	}
+	return d
+}
+
+// jitter randomizes d by up to p.Jitter in either direction.
+func (p RetryPolicy) jitter(d time.Duration) time.Duration {
+	if p.Jitter <= 0 || d <= 0 {
+		return d
+	}
+	return time.Duration(float64(d) * (1 + p.Jitter*(2*rand.Float64()-1)))
+}
@@ -0,0 +1,67 @@
+package anygo_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/daxartio/anygo"
+)
+
+func TestRetry(t *testing.T) {
+	calls := 0
+	r := anygo.Retry(context.Background(), anygo.RetryPolicy{MaxAttempts: 5, InitialDelay: time.Millisecond, Multiplier: 2}, func() anygo.Result[int] {
+		calls++
+		if calls < 3 {
+			return anygo.Err[int](errors.New("transient"))
+		}
+		return anygo.Ok(calls)
+	})
+	if v := r.MustUnwrap(); v != 3 {
+		t.Fatalf("expected success on the third attempt, got %d", v)
+	}
+}
+
+func TestRetryExhausted(t *testing.T) {
+	calls := 0
+	last := errors.New("last")
+	r := anygo.Retry(context.Background(), anygo.RetryPolicy{MaxAttempts: 3}, func() anygo.Result[int] {
+		calls++
+		if calls == 3 {
+			return anygo.Err[int](last)
+		}
+		return anygo.Err[int](errors.New("transient"))
+	})
+	if r.UnwrapError() != last || calls != 3 {
+		t.Fatalf("expected last error after 3 calls, got %v after %d", r.UnwrapError(), calls)
+	}
+}
+
+func TestRetryNotRetryable(t *testing.T) {
+	permanent := errors.New("permanent")
+	calls := 0
+	policy := anygo.RetryPolicy{
+		MaxAttempts: 5,
+		Retryable:   func(err error) bool { return !errors.Is(err, permanent) },
+	}
+	r := anygo.Retry(context.Background(), policy, func() anygo.Result[int] {
+		calls++
+		return anygo.Err[int](permanent)
+	})
+	if r.UnwrapError() != permanent || calls != 1 {
+		t.Fatalf("expected a single call, got %d", calls)
+	}
+}
+
+func TestRetryContextDone(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	transient := errors.New("transient")
+	r := anygo.Retry(ctx, anygo.RetryPolicy{MaxAttempts: 100, InitialDelay: time.Hour}, func() anygo.Result[int] {
+		return anygo.Err[int](transient)
+	})
+	if !r.Is(context.DeadlineExceeded) || !r.Is(transient) {
+		t.Fatalf("expected deadline and last error, got %v", r.UnwrapError())
+	}
+}
@@ -0,0 +1,116 @@
+// Package seqx provides lazy sequence helpers built on iter.Seq.
+//
+// Every helper pulls from its source only as the consumer iterates and
+// stops pulling as soon as the consumer stops.
+package seqx
+
+import (
+	"iter"
+	"slices"
+
+	"github.com/daxartio/anygo"
+)
+
+// FromSlice returns a sequence of the items of s in order.
+func FromSlice[T any](s []T) iter.Seq[T] {
+	return slices.Values(s)
+}
+
+// Map returns a sequence with f applied to each value of seq.
+//
+// Example:
+//
+//	lens := seqx.Map(seqx.FromSlice(words), func(s string) int { return len(s) })
+func Map[T, U any](seq iter.Seq[T], f func(T) U) iter.Seq[U] {
+	return func(yield func(U) bool) {
+		for v := range seq {
+			if !yield(f(v)) {
+				return
+			}
+		}
+	}
+}
+
+// MapE returns a sequence of the Results of f applied to each value of seq.
+//
+// Example:
+//
+//	nums := anygo.CollectSeq(seqx.MapE(seqx.FromSlice(lines), anygo.Wrap1(strconv.Atoi)))
+func MapE[T, U any](seq iter.Seq[T], f func(T) anygo.Result[U]) iter.Seq[anygo.Result[U]] {
+	return Map(seq, f)
+}
+
+// Filter returns a sequence of the values of seq for which pred returns
+// true.
+func Filter[T any](seq iter.Seq[T], pred func(T) bool) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for v := range seq {
+			if pred(v) && !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// Take returns a sequence of at most the first n values of seq.
+func Take[T any](seq iter.Seq[T], n int) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		if n <= 0 {
+			return
+		}
+		i := 0
+		for v := range seq {
+			if !yield(v) {
+				return
+			}
+			i++
+			if i == n {
+				return
+			}
+		}
+	}
+}
+
+// Skip returns a sequence of the values of seq after the first n.
+func Skip[T any](seq iter.Seq[T], n int) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		i := 0
+		for v := range seq {
+			if i < n {
+				i++
+				continue
+			}
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// Chunk returns a sequence of slices of up to n consecutive values of seq.
+// The last chunk may be shorter. Chunk panics if n is less than 1.
+func Chunk[T any](seq iter.Seq[T], n int) iter.Seq[[]T] {
+	if n < 1 {
+		panic("seqx: chunk size must be at least 1")
+	}
+	return func(yield func([]T) bool) {
+		chunk := make([]T, 0, n)
+		for v := range seq {
+			chunk = append(chunk, v)
+			if len(chunk) == n {
+				if !yield(chunk) {
+					return
+				}
+				chunk = make([]T, 0, n)
+			}
+		}
+		if len(chunk) > 0 {
+			yield(chunk)
+		}
+	}
+}
+
+// Collect gathers the values of seq into a slice.
+func Collect[T any](seq iter.Seq[T]) []T {
+	return slices.Collect(seq)
+}
@@ -0,0 +1,65 @@
+package seqx_test
+
+import (
+	"slices"
+	"strconv"
+	"testing"
+
+	"github.com/daxartio/anygo"
+	"github.com/daxartio/anygo/seqx"
+)
+
+func TestMapFilter(t *testing.T) {
+	seq := seqx.Filter(seqx.Map(seqx.FromSlice([]int{1, 2, 3, 4}), func(i int) int { return i * 10 }), func(i int) bool {
+		return i > 15
+	})
+	if got := seqx.Collect(seq); !slices.Equal(got, []int{20, 30, 40}) {
+		t.Fatalf("expected [20 30 40], got %v", got)
+	}
+}
+
+func TestMapE(t *testing.T) {
+	rs := seqx.Collect(seqx.MapE(seqx.FromSlice([]string{"1", "x"}), anygo.Wrap1(strconv.Atoi)))
+	if len(rs) != 2 || rs[0].MustUnwrap() != 1 || !rs[1].IsErr() {
+		t.Fatalf("unexpected results %v", rs)
+	}
+	if r := anygo.CollectSeq(seqx.MapE(seqx.FromSlice([]string{"1", "2"}), anygo.Wrap1(strconv.Atoi))); len(r.MustUnwrap()) != 2 {
+		t.Fatal("expected MapE to compose with CollectSeq")
+	}
+}
+
+func TestTakeSkip(t *testing.T) {
+	seq := seqx.FromSlice([]int{1, 2, 3, 4, 5})
+	if got := seqx.Collect(seqx.Take(seq, 2)); !slices.Equal(got, []int{1, 2}) {
+		t.Fatalf("expected [1 2], got %v", got)
+	}
+	if got := seqx.Collect(seqx.Skip(seq, 3)); !slices.Equal(got, []int{4, 5}) {
+		t.Fatalf("expected [4 5], got %v", got)
+	}
+	if got := seqx.Collect(seqx.Take(seq, 0)); len(got) != 0 {
+		t.Fatalf("expected no values, got %v", got)
+	}
+}
+
+func TestTakeStopsPulling(t *testing.T) {
+	pulled := 0
+	seq := func(yield func(int) bool) {
+		for i := 0; ; i++ {
+			pulled++
+			if !yield(i) {
+				return
+			}
+		}
+	}
+	seqx.Collect(seqx.Take(seq, 3))
+	if pulled != 3 {
+		t.Fatalf("expected 3 pulls, got %d", pulled)
+	}
+}
+
+func TestChunk(t *testing.T) {
+	got := seqx.Collect(seqx.Chunk(seqx.FromSlice([]int{1, 2, 3, 4, 5}), 2))
+	if len(got) != 3 || !slices.Equal(got[0], []int{1, 2}) || !slices.Equal(got[2], []int{5}) {
+		t.Fatalf("unexpected chunks %v", got)
+	}
+}
@@ -0,0 +1,68 @@
+package anygo
+
+import "sync"
+
+// Single deduplicates concurrent calls that share a key: while a call for a
+// key is in flight, later callers with the same key wait for it and receive
+// its Result instead of running their own. The zero value is ready to use.
+type Single[K comparable, V any] struct {
+	mu    sync.Mutex
+	calls map[K]*singleCall[V]
+}
+
+type singleCall[V any] struct {
+	done   chan struct{}
+	result Result[V]
+}
+
+// Do runs f for k unless a call for k is already in flight, in which case it
+// waits for that call and returns its Result. A panic in f is returned to
+// every waiter as an Err wrapping a *PanicError.
+//
+// Results are not cached: once a call completes, the next Do for the same
+// key runs f again.
+//
+// Example:
+//
+//	var users anygo.Single[string, *User]
+//	u := users.Do(id, func() anygo.Result[*User] {
+//		return anygo.Try(db.LoadUser(id))
+//	})
+func (s *Single[K, V]) Do(k K, f func() Result[V]) (r Result[V]) {
+	s.mu.Lock()
+	if c, ok := s.calls[k]; ok {
+		s.mu.Unlock()
+		<-c.done
+		return c.result
+	}
+	if s.calls == nil {
+		s.calls = make(map[K]*singleCall[V])
+	}
+	c := &singleCall[V]{done: make(chan struct{})}
+	s.calls[k] = c
+	s.mu.Unlock()
+
+	defer func() {
+		if p := recover(); p != nil {
+			c.result = Err[V](panicError(p))
+		}
+		s.mu.Lock()
+		if s.calls[k] == c {
+			delete(s.calls, k)
+		}
+		s.mu.Unlock()
+		close(c.done)
+		r = c.result
+	}()
+	c.result = f()
+	return c.result
+}
+
+// Forget stops sharing the in-flight call for k, if any, so that the next Do
+// for k runs f again. Callers already waiting still receive the original
+// Result.
+func (s *Single[K, V]) Forget(k K) {
+	s.mu.Lock()
+	delete(s.calls, k)
+	s.mu.Unlock()
+}
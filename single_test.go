@@ -0,0 +1,75 @@
+package anygo_test
+
+import (
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/daxartio/anygo"
+)
+
+func TestSingleDeduplicates(t *testing.T) {
+	var (
+		s       anygo.Single[string, int]
+		calls   atomic.Int32
+		started = make(chan struct{})
+		release = make(chan struct{})
+		wg      sync.WaitGroup
+	)
+	f := func() anygo.Result[int] {
+		calls.Add(1)
+		close(started)
+		<-release
+		return anygo.Ok(42)
+	}
+	results := make([]anygo.Result[int], 5)
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		results[0] = s.Do("k", f)
+	}()
+	<-started
+	for i := 1; i < len(results); i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			results[i] = s.Do("k", f)
+		}()
+	}
+	time.Sleep(20 * time.Millisecond)
+	close(release)
+	wg.Wait()
+	for _, r := range results {
+		if r.MustUnwrap() != 42 {
+			t.Fatalf("expected shared 42, got %v", r)
+		}
+	}
+	if calls.Load() != 1 {
+		t.Fatalf("expected 1 call, got %d", calls.Load())
+	}
+}
+
+func TestSingleRunsAgainAfterCompletion(t *testing.T) {
+	var s anygo.Single[int, int]
+	calls := 0
+	f := func() anygo.Result[int] {
+		calls++
+		return anygo.Ok(calls)
+	}
+	s.Do(1, f)
+	if r := s.Do(1, f); r.MustUnwrap() != 2 {
+		t.Fatalf("expected second call to run f again, got %v", r)
+	}
+	s.Forget(1)
+}
+
+func TestSinglePanic(t *testing.T) {
+	var s anygo.Single[int, int]
+	r := s.Do(1, func() anygo.Result[int] { panic("boom") })
+	var pe *anygo.PanicError
+	if !errors.As(r.UnwrapError(), &pe) || pe.Value != "boom" {
+		t.Fatalf("expected PanicError, got %v", r)
+	}
+}
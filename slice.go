@@ -0,0 +1,229 @@
+package anygo
+
+import "errors"
+
+// ErrNoResults is returned by helpers that need at least one Result but
+// were given none.
+var ErrNoResults = errors.New("anygo: no results")
+
+// Partition splits rs into the values of the Ok Results and the errors of
+// the Err Results, both in input order.
+//
+// Example:
+//
+//	vals, errs := anygo.Partition(results)
+//	log.Printf("%d succeeded, %d failed", len(vals), len(errs))
+func Partition[T any](rs []Result[T]) ([]T, []error) {
+	var (
+		vals []T
+		errs []error
+	)
+	for _, r := range rs {
+		if r.IsErr() {
+			errs = append(errs, r.err)
+			continue
+		}
+		vals = append(vals, r.value)
+	}
+	return vals, errs
+}
+
+// FirstOk returns the first Ok Result in order. If every Result is Err, the
+// last Err is returned. If no Results are given, it returns Err(ErrNoResults).
+//
+// Example:
+//
+//	r := anygo.FirstOk(fromCache(), fromDB(), fromAPI())
+func FirstOk[T any](rs ...Result[T]) Result[T] {
+	if len(rs) == 0 {
+		return Err[T](ErrNoResults)
+	}
+	for _, r := range rs {
+		if r.IsOk() {
+			return r
+		}
+	}
+	return rs[len(rs)-1]
+}
+
+// Fallback calls each function in order and returns the first Ok Result
+// without calling the rest. If every function fails, it returns an Err
+// joining all the errors with errors.Join. If no functions are given, it
+// returns Err(ErrNoResults).
+//
+// Unlike FirstOk, the alternatives are only computed when needed.
+//
+// Example:
+//
+//	cfg := anygo.Fallback(loadFromEnv, loadFromFile, loadDefaults)
+func Fallback[T any](fns ...func() Result[T]) Result[T] {
+	if len(fns) == 0 {
+		return Err[T](ErrNoResults)
+	}
+	errs := make([]error, 0, len(fns))
+	for _, f := range fns {
+		r := f()
+		if r.IsOk() {
+			return r
+		}
+		errs = append(errs, r.err)
+	}
+	return Err[T](errors.Join(errs...))
+}
+
+// AllOk returns true if every Result is Ok.
+//
+// Example:
+//
+//	fmt.Println(anygo.AllOk(anygo.Ok(1), anygo.Ok(2))) // true
+func AllOk[T any](rs ...Result[T]) bool {
+	for _, r := range rs {
+		if r.IsErr() {
+			return false
+		}
+	}
+	return true
+}
+
+// OkSlice returns the values of the Ok Results in order, skipping every Err.
+// The returned slice is never nil.
+//
+// Example:
+//
+//	vals := anygo.OkSlice([]anygo.Result[int]{anygo.Ok(1), anygo.Err[int](err)})
+//	fmt.Println(vals) // [1]
+func OkSlice[T any](rs []Result[T]) []T {
+	vals := make([]T, 0, len(rs))
+	for _, r := range rs {
+		if r.IsOk() {
+			vals = append(vals, r.value)
+		}
+	}
+	return vals
+}
+
+// CountOk returns the number of Ok Results.
+func CountOk[T any](rs []Result[T]) int {
+	n := 0
+	for _, r := range rs {
+		if r.IsOk() {
+			n++
+		}
+	}
+	return n
+}
+
+// CountErr returns the number of Err Results.
+func CountErr[T any](rs []Result[T]) int {
+	return len(rs) - CountOk(rs)
+}
+
+// AnyErr returns true if at least one Result is Err. It stops at the first
+// Err it finds.
+func AnyErr[T any](rs []Result[T]) bool {
+	return !AllOk(rs...)
+}
+
+// JoinErrors returns nil if every Result is Ok, or errors.Join of all errors
+// in order otherwise.
+//
+// Example:
+//
+//	if err := anygo.JoinErrors(results); err != nil {
+//		return err
+//	}
+func JoinErrors[T any](rs []Result[T]) error {
+	var errs []error
+	for _, r := range rs {
+		if r.IsErr() {
+			errs = append(errs, r.err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// CompareResults returns a comparator for slices.SortFunc that orders Ok
+// Results before Err Results and orders Ok Results by less. Two Err Results
+// compare equal.
+//
+// Example:
+//
+//	slices.SortFunc(rs, anygo.CompareResults(func(a, b int) bool { return a < b }))
+func CompareResults[T any](less func(a, b T) bool) func(a, b Result[T]) int {
+	return compareResults(less, -1)
+}
+
+// CompareResultsErrFirst is like CompareResults but orders Err Results
+// before Ok Results.
+func CompareResultsErrFirst[T any](less func(a, b T) bool) func(a, b Result[T]) int {
+	return compareResults(less, 1)
+}
+
+// compareResults builds a comparator where okOrder is returned when a is Ok
+// and b is Err.
+func compareResults[T any](less func(a, b T) bool, okOrder int) func(a, b Result[T]) int {
+	return func(a, b Result[T]) int {
+		switch {
+		case a.IsErr() && b.IsErr():
+			return 0
+		case a.IsErr():
+			return -okOrder
+		case b.IsErr():
+			return okOrder
+		case less(a.value, b.value):
+			return -1
+		case less(b.value, a.value):
+			return 1
+		default:
+			return 0
+		}
+	}
+}
+
+// GroupBy groups the values of the Ok Results by key and collects the
+// errors of the Err Results. Values within a group and the errors keep
+// their input order; iteration order over the map is unspecified.
+//
+// Example:
+//
+//	groups, errs := anygo.GroupBy(users, func(u User) string { return u.Team })
+func GroupBy[T any, K comparable](rs []Result[T], key func(T) K) (map[K][]T, []error) {
+	groups := make(map[K][]T)
+	var errs []error
+	for _, r := range rs {
+		if r.IsErr() {
+			errs = append(errs, r.err)
+			continue
+		}
+		k := key(r.value)
+		groups[k] = append(groups[k], r.value)
+	}
+	return groups, errs
+}
+
+// Collect gathers the values of rs into a slice. It returns the first Err
+// if any Result failed.
+//
+// Example:
+//
+//	r := anygo.Collect([]anygo.Result[int]{anygo.Ok(1), anygo.Ok(2)})
+//	fmt.Println(r.MustUnwrap()) // [1 2]
+func Collect[T any](rs []Result[T]) Result[[]T] {
+	vals := make([]T, 0, len(rs))
+	for _, r := range rs {
+		if r.IsErr() {
+			return Err[[]T](r.err)
+		}
+		vals = append(vals, r.value)
+	}
+	return Ok(vals)
+}
+
+// CollectAll is like Collect but reports every failure, joined with
+// errors.Join, instead of only the first.
+func CollectAll[T any](rs []Result[T]) Result[[]T] {
+	if err := JoinErrors(rs); err != nil {
+		return Err[[]T](err)
+	}
+	return Ok(OkSlice(rs))
+}
@@ -0,0 +1,172 @@
+package anygo_test
+
+import (
+	"errors"
+	"slices"
+	"testing"
+
+	"github.com/daxartio/anygo"
+)
+
+func TestFirstOk(t *testing.T) {
+	r := anygo.FirstOk(anygo.Err[int](errors.New("a")), anygo.Ok(2), anygo.Ok(3))
+	if v := r.MustUnwrap(); v != 2 {
+		t.Fatalf("expected 2, got %d", v)
+	}
+
+	last := errors.New("b")
+	r = anygo.FirstOk(anygo.Err[int](errors.New("a")), anygo.Err[int](last))
+	if r.UnwrapError() != last {
+		t.Fatal("expected last error")
+	}
+
+	if r := anygo.FirstOk[int](); !errors.Is(r.UnwrapError(), anygo.ErrNoResults) {
+		t.Fatal("expected ErrNoResults")
+	}
+}
+
+func TestFallback(t *testing.T) {
+	calls := 0
+	r := anygo.Fallback(
+		func() anygo.Result[int] { calls++; return anygo.Err[int](errors.New("a")) },
+		func() anygo.Result[int] { calls++; return anygo.Ok(2) },
+		func() anygo.Result[int] { calls++; return anygo.Ok(3) },
+	)
+	if v := r.MustUnwrap(); v != 2 || calls != 2 {
+		t.Fatalf("expected 2 after 2 calls, got %d after %d", v, calls)
+	}
+
+	a, b := errors.New("a"), errors.New("b")
+	r = anygo.Fallback(
+		func() anygo.Result[int] { return anygo.Err[int](a) },
+		func() anygo.Result[int] { return anygo.Err[int](b) },
+	)
+	if err := r.UnwrapError(); !errors.Is(err, a) || !errors.Is(err, b) {
+		t.Fatalf("expected joined errors, got %v", err)
+	}
+
+	if r := anygo.Fallback[int](); !errors.Is(r.UnwrapError(), anygo.ErrNoResults) {
+		t.Fatal("expected ErrNoResults")
+	}
+}
+
+func TestAllOk(t *testing.T) {
+	if !anygo.AllOk(anygo.Ok(1), anygo.Ok(2)) {
+		t.Fatal("expected all Ok")
+	}
+	if anygo.AllOk(anygo.Ok(1), anygo.Err[int](errors.New("fail"))) {
+		t.Fatal("expected not all Ok")
+	}
+}
+
+func TestOkSlice(t *testing.T) {
+	rs := []anygo.Result[int]{anygo.Ok(1), anygo.Err[int](errors.New("fail")), anygo.Ok(3)}
+	if got := anygo.OkSlice(rs); !slices.Equal(got, []int{1, 3}) {
+		t.Fatalf("expected [1 3], got %v", got)
+	}
+
+	got := anygo.OkSlice([]anygo.Result[int]{anygo.Err[int](errors.New("fail"))})
+	if got == nil || len(got) != 0 {
+		t.Fatalf("expected empty non-nil slice, got %#v", got)
+	}
+}
+
+func TestCountOkErr(t *testing.T) {
+	rs := []anygo.Result[int]{anygo.Ok(1), anygo.Err[int](errors.New("fail")), anygo.Ok(3)}
+	if n := anygo.CountOk(rs); n != 2 {
+		t.Fatalf("expected 2 Ok, got %d", n)
+	}
+	if n := anygo.CountErr(rs); n != 1 {
+		t.Fatalf("expected 1 Err, got %d", n)
+	}
+}
+
+func TestAnyErr(t *testing.T) {
+	if anygo.AnyErr([]anygo.Result[int]{anygo.Ok(1)}) {
+		t.Fatal("expected no errors")
+	}
+	if !anygo.AnyErr([]anygo.Result[int]{anygo.Ok(1), anygo.Err[int](errors.New("fail"))}) {
+		t.Fatal("expected an error")
+	}
+}
+
+func TestJoinErrors(t *testing.T) {
+	if err := anygo.JoinErrors([]anygo.Result[int]{anygo.Ok(1), anygo.Ok(2)}); err != nil {
+		t.Fatalf("expected nil, got %v", err)
+	}
+
+	a, b := errors.New("a"), errors.New("b")
+	err := anygo.JoinErrors([]anygo.Result[int]{anygo.Err[int](a), anygo.Ok(1), anygo.Err[int](b)})
+	if !errors.Is(err, a) || !errors.Is(err, b) {
+		t.Fatal("expected joined error to match both sentinels")
+	}
+}
+
+func TestCompareResults(t *testing.T) {
+	fail := errors.New("fail")
+	rs := []anygo.Result[int]{anygo.Err[int](fail), anygo.Ok(3), anygo.Ok(1)}
+	slices.SortFunc(rs, anygo.CompareResults(func(a, b int) bool { return a < b }))
+	if rs[0].MustUnwrap() != 1 || rs[1].MustUnwrap() != 3 || !rs[2].IsErr() {
+		t.Fatalf("unexpected order: %v", rs)
+	}
+
+	slices.SortFunc(rs, anygo.CompareResultsErrFirst(func(a, b int) bool { return a < b }))
+	if !rs[0].IsErr() || rs[1].MustUnwrap() != 1 || rs[2].MustUnwrap() != 3 {
+		t.Fatalf("unexpected order: %v", rs)
+	}
+
+	cmp := anygo.CompareResults(func(a, b int) bool { return a < b })
+	if cmp(anygo.Err[int](fail), anygo.Err[int](errors.New("other"))) != 0 {
+		t.Fatal("expected two errors to compare equal")
+	}
+}
+
+func TestGroupBy(t *testing.T) {
+	a, b := errors.New("a"), errors.New("b")
+	rs := []anygo.Result[int]{
+		anygo.Ok(1), anygo.Err[int](a), anygo.Ok(2), anygo.Ok(3), anygo.Err[int](b),
+	}
+	groups, errs := anygo.GroupBy(rs, func(i int) bool { return i%2 == 0 })
+	if !slices.Equal(groups[false], []int{1, 3}) || !slices.Equal(groups[true], []int{2}) {
+		t.Fatalf("unexpected groups: %v", groups)
+	}
+	if len(errs) != 2 || errs[0] != a || errs[1] != b {
+		t.Fatalf("unexpected errors: %v", errs)
+	}
+}
+
+func TestCollect(t *testing.T) {
+	r := anygo.Collect([]anygo.Result[int]{anygo.Ok(1), anygo.Ok(2)})
+	if got := r.MustUnwrap(); !slices.Equal(got, []int{1, 2}) {
+		t.Fatalf("expected [1 2], got %v", got)
+	}
+
+	first := errors.New("first")
+	r = anygo.Collect([]anygo.Result[int]{anygo.Ok(1), anygo.Err[int](first), anygo.Err[int](errors.New("second"))})
+	if r.UnwrapError() != first {
+		t.Fatalf("expected first error, got %v", r.UnwrapError())
+	}
+}
+
+func TestCollectAll(t *testing.T) {
+	if got := anygo.CollectAll([]anygo.Result[int]{anygo.Ok(1), anygo.Ok(2)}).MustUnwrap(); !slices.Equal(got, []int{1, 2}) {
+		t.Fatalf("expected [1 2], got %v", got)
+	}
+
+	a, b := errors.New("a"), errors.New("b")
+	r := anygo.CollectAll([]anygo.Result[int]{anygo.Err[int](a), anygo.Ok(1), anygo.Err[int](b)})
+	if err := r.UnwrapError(); !errors.Is(err, a) || !errors.Is(err, b) {
+		t.Fatalf("expected both errors, got %v", err)
+	}
+}
+
+func TestPartition(t *testing.T) {
+	a, b := errors.New("a"), errors.New("b")
+	vals, errs := anygo.Partition([]anygo.Result[int]{anygo.Ok(1), anygo.Err[int](a), anygo.Ok(2), anygo.Err[int](b)})
+	if !slices.Equal(vals, []int{1, 2}) {
+		t.Fatalf("expected [1 2], got %v", vals)
+	}
+	if len(errs) != 2 || errs[0] != a || errs[1] != b {
+		t.Fatalf("unexpected errors %v", errs)
+	}
+}
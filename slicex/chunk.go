@@ -0,0 +1,59 @@
+package slicex
+
+// Chunk splits items into consecutive chunks of n items. The last chunk may
+// be shorter. The chunks share memory with items but are capacity-limited,
+// so appending to one does not overwrite the next. Chunk panics if n is
+// less than 1.
+//
+// Example:
+//
+//	for _, batch := range slicex.Chunk(rows, 500) {
+//		insert(batch)
+//	}
+func Chunk[T any](items []T, n int) [][]T {
+	if n < 1 {
+		panic("slicex: Chunk size must be at least 1")
+	}
+	out := make([][]T, 0, (len(items)+n-1)/n)
+	for i := 0; i < len(items); i += n {
+		end := min(i+n, len(items))
+		out = append(out, items[i:end:end])
+	}
+	return out
+}
+
+// Windows returns every run of n consecutive items, in order. It returns no
+// windows if items has fewer than n items. Like Chunk, the windows share
+// memory with items and are capacity-limited. Windows panics if n is less
+// than 1.
+//
+// Example:
+//
+//	ws := slicex.Windows([]int{1, 2, 3, 4}, 3)
+//	fmt.Println(ws) // [[1 2 3] [2 3 4]]
+func Windows[T any](items []T, n int) [][]T {
+	if n < 1 {
+		panic("slicex: Windows size must be at least 1")
+	}
+	if len(items) < n {
+		return [][]T{}
+	}
+	out := make([][]T, 0, len(items)-n+1)
+	for i := 0; i+n <= len(items); i++ {
+		out = append(out, items[i:i+n:i+n])
+	}
+	return out
+}
+
+// Flatten concatenates the inner slices into a single new slice.
+func Flatten[T any](items [][]T) []T {
+	size := 0
+	for _, inner := range items {
+		size += len(inner)
+	}
+	out := make([]T, 0, size)
+	for _, inner := range items {
+		out = append(out, inner...)
+	}
+	return out
+}
@@ -0,0 +1,51 @@
+package slicex_test
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/daxartio/anygo/slicex"
+)
+
+func equalNested(a, b [][]int) bool {
+	return slices.EqualFunc(a, b, slices.Equal[[]int])
+}
+
+func TestChunk(t *testing.T) {
+	got := slicex.Chunk([]int{1, 2, 3, 4, 5}, 2)
+	if !equalNested(got, [][]int{{1, 2}, {3, 4}, {5}}) {
+		t.Fatalf("unexpected chunks %v", got)
+	}
+	got[0] = append(got[0], 9)
+	if got[1][0] != 3 {
+		t.Fatal("expected append to a chunk not to overwrite the next one")
+	}
+	if got := slicex.Chunk([]int{}, 3); len(got) != 0 {
+		t.Fatalf("expected no chunks, got %v", got)
+	}
+}
+
+func TestWindows(t *testing.T) {
+	got := slicex.Windows([]int{1, 2, 3, 4}, 3)
+	if !equalNested(got, [][]int{{1, 2, 3}, {2, 3, 4}}) {
+		t.Fatalf("unexpected windows %v", got)
+	}
+	if got := slicex.Windows([]int{1}, 2); len(got) != 0 {
+		t.Fatalf("expected no windows, got %v", got)
+	}
+}
+
+func TestChunkPanicsOnZeroSize(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Fatal("expected panic")
+		}
+	}()
+	slicex.Chunk([]int{1}, 0)
+}
+
+func TestFlatten(t *testing.T) {
+	if got := slicex.Flatten([][]int{{1, 2}, {}, {3}}); !slices.Equal(got, []int{1, 2, 3}) {
+		t.Fatalf("expected [1 2 3], got %v", got)
+	}
+}
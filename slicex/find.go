@@ -0,0 +1,76 @@
+package slicex
+
+import (
+	"cmp"
+
+	"github.com/daxartio/anygo"
+)
+
+// Find returns the first item for which pred returns true, or None.
+//
+// Example:
+//
+//	admin := slicex.Find(users, func(u User) bool { return u.Admin })
+func Find[T any](items []T, pred func(T) bool) anygo.Option[T] {
+	for _, v := range items {
+		if pred(v) {
+			return anygo.Some(v)
+		}
+	}
+	return anygo.None[T]()
+}
+
+// FindLast returns the last item for which pred returns true, or None.
+func FindLast[T any](items []T, pred func(T) bool) anygo.Option[T] {
+	for i := len(items) - 1; i >= 0; i-- {
+		if pred(items[i]) {
+			return anygo.Some(items[i])
+		}
+	}
+	return anygo.None[T]()
+}
+
+// First returns the first item, or None for an empty slice.
+func First[T any](items []T) anygo.Option[T] {
+	if len(items) == 0 {
+		return anygo.None[T]()
+	}
+	return anygo.Some(items[0])
+}
+
+// Last returns the last item, or None for an empty slice.
+func Last[T any](items []T) anygo.Option[T] {
+	if len(items) == 0 {
+		return anygo.None[T]()
+	}
+	return anygo.Some(items[len(items)-1])
+}
+
+// MinBy returns the item with the smallest key, or None for an empty slice.
+// If several items share the smallest key, the first one is returned.
+//
+// Example:
+//
+//	youngest := slicex.MinBy(users, func(u User) int { return u.Age })
+func MinBy[T any, K cmp.Ordered](items []T, keyFn func(T) K) anygo.Option[T] {
+	return extremeBy(items, keyFn, func(a, b K) bool { return a < b })
+}
+
+// MaxBy returns the item with the largest key, or None for an empty slice.
+// If several items share the largest key, the first one is returned.
+func MaxBy[T any, K cmp.Ordered](items []T, keyFn func(T) K) anygo.Option[T] {
+	return extremeBy(items, keyFn, func(a, b K) bool { return a > b })
+}
+
+func extremeBy[T any, K cmp.Ordered](items []T, keyFn func(T) K, better func(a, b K) bool) anygo.Option[T] {
+	if len(items) == 0 {
+		return anygo.None[T]()
+	}
+	best, bestKey := items[0], keyFn(items[0])
+	for _, v := range items[1:] {
+		if k := keyFn(v); better(k, bestKey) {
+			best, bestKey = v, k
+		}
+	}
+	return anygo.Some(best)
+}
@@ -0,0 +1,45 @@
+package slicex_test
+
+import (
+	"testing"
+
+	"github.com/daxartio/anygo/slicex"
+)
+
+func TestFind(t *testing.T) {
+	items := []int{1, 2, 3, 4}
+	even := func(i int) bool { return i%2 == 0 }
+	if v, ok := slicex.Find(items, even).Unwrap(); !ok || v != 2 {
+		t.Fatalf("expected 2, got %v", v)
+	}
+	if v, ok := slicex.FindLast(items, even).Unwrap(); !ok || v != 4 {
+		t.Fatalf("expected 4, got %v", v)
+	}
+	none := func(int) bool { return false }
+	if slicex.Find(items, none).IsSome() || slicex.FindLast(items, none).IsSome() {
+		t.Fatal("expected None")
+	}
+}
+
+func TestFirstLast(t *testing.T) {
+	if slicex.First([]int{1, 2}).UnwrapOr(0) != 1 || slicex.Last([]int{1, 2}).UnwrapOr(0) != 2 {
+		t.Fatal("unexpected first or last")
+	}
+	if slicex.First([]int{}).IsSome() || slicex.Last[int](nil).IsSome() {
+		t.Fatal("expected None for empty slices")
+	}
+}
+
+func TestMinMaxBy(t *testing.T) {
+	words := []string{"ccc", "a", "bb", "d"}
+	length := func(s string) int { return len(s) }
+	if v := slicex.MinBy(words, length).UnwrapOr(""); v != "a" {
+		t.Fatalf("expected first shortest 'a', got '%s'", v)
+	}
+	if v := slicex.MaxBy(words, length).UnwrapOr(""); v != "ccc" {
+		t.Fatalf("expected 'ccc', got '%s'", v)
+	}
+	if slicex.MinBy([]string{}, length).IsSome() {
+		t.Fatal("expected None for empty slice")
+	}
+}
@@ -0,0 +1,57 @@
+package slicex
+
+// GroupBy groups items by the key returned by keyFn. Items keep their input
+// order within each group.
+//
+// Example:
+//
+//	byLen := slicex.GroupBy([]string{"a", "bb", "c"}, func(s string) int { return len(s) })
+//	fmt.Println(byLen) // map[1:[a c] 2:[bb]]
+func GroupBy[T any, K comparable](items []T, keyFn func(T) K) map[K][]T {
+	out := make(map[K][]T)
+	for _, v := range items {
+		k := keyFn(v)
+		out[k] = append(out[k], v)
+	}
+	return out
+}
+
+// CountBy counts items by the key returned by keyFn.
+//
+// Example:
+//
+//	counts := slicex.CountBy(words, strings.ToLower)
+func CountBy[T any, K comparable](items []T, keyFn func(T) K) map[K]int {
+	out := make(map[K]int)
+	for _, v := range items {
+		out[keyFn(v)]++
+	}
+	return out
+}
+
+// KeyBy indexes items by the key returned by keyFn. If several items share a
+// key, the last one wins.
+//
+// Example:
+//
+//	byID := slicex.KeyBy(users, func(u User) int { return u.ID })
+func KeyBy[T any, K comparable](items []T, keyFn func(T) K) map[K]T {
+	out := make(map[K]T, len(items))
+	for _, v := range items {
+		out[keyFn(v)] = v
+	}
+	return out
+}
+
+// KeyByFirst is like KeyBy, but if several items share a key, the first one
+// wins.
+func KeyByFirst[T any, K comparable](items []T, keyFn func(T) K) map[K]T {
+	out := make(map[K]T, len(items))
+	for _, v := range items {
+		k := keyFn(v)
+		if _, ok := out[k]; !ok {
+			out[k] = v
+		}
+	}
+	return out
+}
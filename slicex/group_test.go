@@ -0,0 +1,34 @@
+package slicex_test
+
+import (
+	"maps"
+	"slices"
+	"testing"
+
+	"github.com/daxartio/anygo/slicex"
+)
+
+func TestGroupBy(t *testing.T) {
+	got := slicex.GroupBy([]string{"a", "bb", "c"}, func(s string) int { return len(s) })
+	if len(got) != 2 || !slices.Equal(got[1], []string{"a", "c"}) || !slices.Equal(got[2], []string{"bb"}) {
+		t.Fatalf("unexpected groups %v", got)
+	}
+}
+
+func TestCountBy(t *testing.T) {
+	got := slicex.CountBy([]int{1, 2, 3, 4, 5}, func(i int) bool { return i%2 == 0 })
+	if !maps.Equal(got, map[bool]int{true: 2, false: 3}) {
+		t.Fatalf("unexpected counts %v", got)
+	}
+}
+
+func TestKeyBy(t *testing.T) {
+	items := []string{"apple", "avocado", "banana"}
+	first := func(s string) byte { return s[0] }
+	if got := slicex.KeyBy(items, first); got['a'] != "avocado" || got['b'] != "banana" {
+		t.Fatalf("expected last to win, got %v", got)
+	}
+	if got := slicex.KeyByFirst(items, first); got['a'] != "apple" || len(got) != 2 {
+		t.Fatalf("expected first to win, got %v", got)
+	}
+}
@@ -0,0 +1,82 @@
+package slicex
+
+import (
+	"math/rand/v2"
+	"slices"
+)
+
+// Reverse returns a copy of items in reverse order.
+func Reverse[T any](items []T) []T {
+	out := slices.Clone(items)
+	slices.Reverse(out)
+	return out
+}
+
+// Shuffle returns a copy of items in random order, drawn from r. If r is
+// nil, the global source of math/rand/v2 is used.
+//
+// Example:
+//
+//	r := rand.New(rand.NewPCG(1, 2)) // reproducible in tests
+//	deck := slicex.Shuffle(cards, r)
+func Shuffle[T any](items []T, r *rand.Rand) []T {
+	out := slices.Clone(items)
+	shuffle(r, len(out), func(i, j int) { out[i], out[j] = out[j], out[i] })
+	return out
+}
+
+// Sample returns n items picked at random from distinct positions of
+// items, drawn from r. If n is larger than len(items), every item is
+// returned in random order. If r is nil, the global source of math/rand/v2
+// is used.
+func Sample[T any](items []T, n int, r *rand.Rand) []T {
+	n = max(0, min(n, len(items)))
+	out := slices.Clone(items)
+	// Partial Fisher-Yates: only the first n positions need to be drawn.
+	for i := range n {
+		j := i + intN(r, len(out)-i)
+		out[i], out[j] = out[j], out[i]
+	}
+	return out[:n:n]
+}
+
+// Transpose swaps the rows and columns of a rectangular matrix. It panics if
+// the rows have different lengths.
+//
+// Example:
+//
+//	fmt.Println(slicex.Transpose([][]int{{1, 2, 3}, {4, 5, 6}})) // [[1 4] [2 5] [3 6]]
+func Transpose[T any](rows [][]T) [][]T {
+	if len(rows) == 0 {
+		return [][]T{}
+	}
+	cols := len(rows[0])
+	out := make([][]T, cols)
+	for j := range out {
+		out[j] = make([]T, len(rows))
+	}
+	for i, row := range rows {
+		if len(row) != cols {
+			panic("slicex: Transpose rows must have the same length")
+		}
+		for j, v := range row {
+			out[j][i] = v
+		}
+	}
+	return out
+}
+
+func shuffle(r *rand.Rand, n int, swap func(i, j int)) {
+	if r == nil {
+		rand.Shuffle(n, swap)
+		return
+	}
+	r.Shuffle(n, swap)
+}
+
+func intN(r *rand.Rand, n int) int {
+	if r == nil {
+		return rand.IntN(n)
+	}
+	return r.IntN(n)
+}
@@ -0,0 +1,68 @@
+package slicex_test
+
+import (
+	"math/rand/v2"
+	"slices"
+	"testing"
+
+	"github.com/daxartio/anygo/slicex"
+)
+
+func TestReverse(t *testing.T) {
+	items := []int{1, 2, 3}
+	if got := slicex.Reverse(items); !slices.Equal(got, []int{3, 2, 1}) || items[0] != 1 {
+		t.Fatalf("expected reversed copy, got %v", got)
+	}
+}
+
+func TestShuffle(t *testing.T) {
+	items := []int{1, 2, 3, 4, 5, 6, 7, 8}
+	a := slicex.Shuffle(items, rand.New(rand.NewPCG(1, 2)))
+	b := slicex.Shuffle(items, rand.New(rand.NewPCG(1, 2)))
+	if !slices.Equal(a, b) {
+		t.Fatal("expected the same seed to give the same order")
+	}
+	slices.Sort(a)
+	if !slices.Equal(a, items) {
+		t.Fatalf("expected a permutation, got %v", a)
+	}
+	if got := slicex.Shuffle(items, nil); len(got) != len(items) {
+		t.Fatal("expected the global source to be used")
+	}
+}
+
+func TestSample(t *testing.T) {
+	items := []int{1, 2, 3, 4, 5}
+	r := rand.New(rand.NewPCG(3, 4))
+	got := slicex.Sample(items, 3, r)
+	if len(got) != 3 || len(slicex.Uniq(got)) != 3 {
+		t.Fatalf("expected 3 distinct items, got %v", got)
+	}
+	for _, v := range got {
+		if !slices.Contains(items, v) {
+			t.Fatalf("unexpected item %d", v)
+		}
+	}
+	if got := slicex.Sample(items, 10, nil); len(got) != 5 {
+		t.Fatalf("expected every item, got %v", got)
+	}
+	if got := slicex.Sample(items, -1, r); len(got) != 0 {
+		t.Fatalf("expected no items, got %v", got)
+	}
+}
+
+func TestTranspose(t *testing.T) {
+	got := slicex.Transpose([][]int{{1, 2, 3}, {4, 5, 6}})
+	if !equalNested(got, [][]int{{1, 4}, {2, 5}, {3, 6}}) {
+		t.Fatalf("unexpected transpose %v", got)
+	}
+	if got := slicex.Transpose[int](nil); len(got) != 0 {
+		t.Fatalf("expected empty result, got %v", got)
+	}
+	defer func() {
+		if recover() == nil {
+			t.Fatal("expected panic on ragged rows")
+		}
+	}()
+	slicex.Transpose([][]int{{1, 2}, {3}})
+}
@@ -0,0 +1,71 @@
+package slicex
+
+// Uniq returns the items with duplicates removed, keeping the first
+// occurrence of each.
+//
+// Example:
+//
+//	fmt.Println(slicex.Uniq([]int{3, 1, 3, 2, 1})) // [3 1 2]
+func Uniq[T comparable](items []T) []T {
+	return UniqBy(items, func(v T) T { return v })
+}
+
+// UniqBy is like Uniq, but two items are duplicates if keyFn returns the same
+// key for them.
+//
+// Example:
+//
+//	users = slicex.UniqBy(users, func(u User) string { return u.Email })
+func UniqBy[T any, K comparable](items []T, keyFn func(T) K) []T {
+	seen := make(map[K]struct{}, len(items))
+	out := make([]T, 0, len(items))
+	for _, v := range items {
+		k := keyFn(v)
+		if _, ok := seen[k]; ok {
+			continue
+		}
+		seen[k] = struct{}{}
+		out = append(out, v)
+	}
+	return out
+}
+
+// Intersect returns the distinct items of a that are also in b, in the
+// order of a.
+func Intersect[T comparable](a, b []T) []T {
+	inB := toSet(b)
+	return Uniq(Filter(a, func(v T) bool {
+		_, ok := inB[v]
+		return ok
+	}))
+}
+
+// Difference returns the distinct items of a that are not in b, in the
+// order of a.
+func Difference[T comparable](a, b []T) []T {
+	inB := toSet(b)
+	return Uniq(Filter(a, func(v T) bool {
+		_, ok := inB[v]
+		return !ok
+	}))
+}
+
+// Union returns the distinct items of a followed by the distinct items of b
+// that are not in a.
+//
+// Example:
+//
+//	fmt.Println(slicex.Union([]int{1, 2}, []int{2, 3})) // [1 2 3]
+func Union[T comparable](a, b []T) []T {
+	out := make([]T, 0, len(a)+len(b))
+	out = append(out, a...)
+	return Uniq(append(out, b...))
+}
+
+func toSet[T comparable](items []T) map[T]struct{} {
+	set := make(map[T]struct{}, len(items))
+	for _, v := range items {
+		set[v] = struct{}{}
+	}
+	return set
+}
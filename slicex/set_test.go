@@ -0,0 +1,32 @@
+package slicex_test
+
+import (
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/daxartio/anygo/slicex"
+)
+
+func TestUniq(t *testing.T) {
+	if got := slicex.Uniq([]int{3, 1, 3, 2, 1}); !slices.Equal(got, []int{3, 1, 2}) {
+		t.Fatalf("expected [3 1 2], got %v", got)
+	}
+	got := slicex.UniqBy([]string{"a", "B", "A", "b"}, strings.ToLower)
+	if !slices.Equal(got, []string{"a", "B"}) {
+		t.Fatalf("expected [a B], got %v", got)
+	}
+}
+
+func TestSetOperations(t *testing.T) {
+	a, b := []int{4, 1, 2, 2, 3}, []int{3, 2, 5}
+	if got := slicex.Intersect(a, b); !slices.Equal(got, []int{2, 3}) {
+		t.Fatalf("unexpected intersection %v", got)
+	}
+	if got := slicex.Difference(a, b); !slices.Equal(got, []int{4, 1}) {
+		t.Fatalf("unexpected difference %v", got)
+	}
+	if got := slicex.Union(a, b); !slices.Equal(got, []int{4, 1, 2, 3, 5}) {
+		t.Fatalf("unexpected union %v", got)
+	}
+}
@@ -0,0 +1,209 @@
+// Package slicex provides generic slice helpers that work with anygo's
+// Result and Option types.
+package slicex
+
+import (
+	"slices"
+
+	"github.com/daxartio/anygo"
+)
+
+// Map returns a new slice with f applied to each item.
+//
+// Example:
+//
+//	lens := slicex.Map([]string{"a", "bb"}, func(s string) int { return len(s) })
+//	fmt.Println(lens) // [1 2]
+func Map[T, U any](items []T, f func(T) U) []U {
+	out := make([]U, len(items))
+	for i, v := range items {
+		out[i] = f(v)
+	}
+	return out
+}
+
+// MapE applies f to each item and collects the values, stopping at the
+// first Err.
+//
+// Example:
+//
+//	r := slicex.MapE([]string{"1", "2"}, anygo.Wrap1(strconv.Atoi))
+//	fmt.Println(r.MustUnwrap()) // [1 2]
+func MapE[T, U any](items []T, f func(T) anygo.Result[U]) anygo.Result[[]U] {
+	out := make([]U, 0, len(items))
+	for _, v := range items {
+		val, err := f(v).Unwrap()
+		if err != nil {
+			return anygo.Err[[]U](err)
+		}
+		out = append(out, val)
+	}
+	return anygo.Ok(out)
+}
+
+// Filter returns a new slice with the items for which pred returns true.
+func Filter[T any](items []T, pred func(T) bool) []T {
+	out := make([]T, 0, len(items))
+	for _, v := range items {
+		if pred(v) {
+			out = append(out, v)
+		}
+	}
+	return out
+}
+
+// PartitionBy splits items into those for which pred returns true and those
+// for which it returns false, both in input order.
+//
+// Example:
+//
+//	even, odd := slicex.PartitionBy([]int{1, 2, 3}, func(i int) bool { return i%2 == 0 })
+func PartitionBy[T any](items []T, pred func(T) bool) ([]T, []T) {
+	var yes, no []T
+	for _, v := range items {
+		if pred(v) {
+			yes = append(yes, v)
+		} else {
+			no = append(no, v)
+		}
+	}
+	return yes, no
+}
+
+// Reduce combines the items from left to right with f, using the first item
+// as the initial accumulator. It returns None for an empty slice.
+//
+// Example:
+//
+//	sum := slicex.Reduce([]int{1, 2, 3}, func(acc, v int) int { return acc + v })
+//	fmt.Println(sum.UnwrapOr(0)) // 6
+func Reduce[T any](items []T, f func(acc, item T) T) anygo.Option[T] {
+	if len(items) == 0 {
+		return anygo.None[T]()
+	}
+	acc := items[0]
+	for _, v := range items[1:] {
+		acc = f(acc, v)
+	}
+	return anygo.Some(acc)
+}
+
+// ReduceRight is like Reduce but combines the items from right to left,
+// using the last item as the initial accumulator.
+func ReduceRight[T any](items []T, f func(acc, item T) T) anygo.Option[T] {
+	if len(items) == 0 {
+		return anygo.None[T]()
+	}
+	acc := items[len(items)-1]
+	for i := len(items) - 2; i >= 0; i-- {
+		acc = f(acc, items[i])
+	}
+	return anygo.Some(acc)
+}
+
+// Fold combines the items from left to right with f, starting from init.
+// It returns init for an empty slice.
+//
+// Example:
+//
+//	total := slicex.Fold(orders, 0.0, func(acc float64, o Order) float64 { return acc + o.Amount })
+func Fold[T, U any](items []T, init U, f func(acc U, item T) U) U {
+	acc := init
+	for _, v := range items {
+		acc = f(acc, v)
+	}
+	return acc
+}
+
+// Scan is like Fold but returns the accumulator after each item, so the
+// result has one entry per item and its last entry equals the Fold.
+//
+// Example:
+//
+//	running := slicex.Scan([]int{10, -3, 5}, 0, func(acc, v int) int { return acc + v })
+//	fmt.Println(running) // [10 7 12]
+func Scan[T, U any](items []T, init U, f func(acc U, item T) U) []U {
+	out := make([]U, len(items))
+	acc := init
+	for i, v := range items {
+		acc = f(acc, v)
+		out[i] = acc
+	}
+	return out
+}
+
+// FlatMap applies f to each item and concatenates the results.
+func FlatMap[T, U any](items []T, f func(T) []U) []U {
+	var out []U
+	for _, v := range items {
+		out = append(out, f(v)...)
+	}
+	return out
+}
+
+// ForEach calls f for each item in order.
+func ForEach[T any](items []T, f func(T)) {
+	for _, v := range items {
+		f(v)
+	}
+}
+
+// Contains reports whether v is in items.
+func Contains[T comparable](items []T, v T) bool {
+	return slices.Contains(items, v)
+}
+
+// IndexOf returns the index of the first occurrence of v in items, or -1 if
+// it is not present.
+func IndexOf[T comparable](items []T, v T) int {
+	return slices.Index(items, v)
+}
+
+// Zip pairs up the items of as and bs by index. The result is as long as
+// the shorter input.
+//
+// Example:
+//
+//	ps := slicex.Zip([]string{"a", "b"}, []int{1, 2})
+//	fmt.Println(ps[1].First, ps[1].Second) // b 2
+func Zip[A, B any](as []A, bs []B) []anygo.Pair[A, B] {
+	n := min(len(as), len(bs))
+	out := make([]anygo.Pair[A, B], n)
+	for i := range n {
+		out[i] = anygo.NewPair(as[i], bs[i])
+	}
+	return out
+}
+
+// Unzip splits pairs into a slice of first values and a slice of second
+// values.
+func Unzip[A, B any](ps []anygo.Pair[A, B]) ([]A, []B) {
+	as := make([]A, len(ps))
+	bs := make([]B, len(ps))
+	for i, p := range ps {
+		as[i], bs[i] = p.Unpack()
+	}
+	return as, bs
+}
+
+// Zip3 groups the items of as, bs and cs by index. The result is as long as
+// the shortest input.
+func Zip3[A, B, C any](as []A, bs []B, cs []C) []anygo.Triple[A, B, C] {
+	n := min(len(as), len(bs), len(cs))
+	out := make([]anygo.Triple[A, B, C], n)
+	for i := range n {
+		out[i] = anygo.NewTriple(as[i], bs[i], cs[i])
+	}
+	return out
+}
+
+// Unzip3 splits triples into three slices.
+func Unzip3[A, B, C any](ts []anygo.Triple[A, B, C]) ([]A, []B, []C) {
+	as := make([]A, len(ts))
+	bs := make([]B, len(ts))
+	cs := make([]C, len(ts))
+	for i, t := range ts {
+		as[i], bs[i], cs[i] = t.Unpack()
+	}
+	return as, bs, cs
+}
@@ -0,0 +1,134 @@
+package slicex_test
+
+import (
+	"slices"
+	"strconv"
+	"testing"
+
+	"github.com/daxartio/anygo"
+	"github.com/daxartio/anygo/slicex"
+)
+
+func TestMap(t *testing.T) {
+	got := slicex.Map([]string{"a", "bb"}, func(s string) int { return len(s) })
+	if !slices.Equal(got, []int{1, 2}) {
+		t.Fatalf("expected [1 2], got %v", got)
+	}
+}
+
+func TestMapE(t *testing.T) {
+	atoi := anygo.Wrap1(strconv.Atoi)
+	if got := slicex.MapE([]string{"1", "2"}, atoi).MustUnwrap(); !slices.Equal(got, []int{1, 2}) {
+		t.Fatalf("expected [1 2], got %v", got)
+	}
+
+	calls := 0
+	r := slicex.MapE([]string{"1", "x", "3"}, func(s string) anygo.Result[int] {
+		calls++
+		return atoi(s)
+	})
+	if !r.IsErr() || calls != 2 {
+		t.Fatalf("expected to stop at the first error, got %d calls", calls)
+	}
+}
+
+func TestFilter(t *testing.T) {
+	got := slicex.Filter([]int{1, 2, 3, 4}, func(i int) bool { return i%2 == 0 })
+	if !slices.Equal(got, []int{2, 4}) {
+		t.Fatalf("expected [2 4], got %v", got)
+	}
+}
+
+func TestPartitionBy(t *testing.T) {
+	even, odd := slicex.PartitionBy([]int{1, 2, 3, 4, 5}, func(i int) bool { return i%2 == 0 })
+	if !slices.Equal(even, []int{2, 4}) || !slices.Equal(odd, []int{1, 3, 5}) {
+		t.Fatalf("unexpected partition %v %v", even, odd)
+	}
+}
+
+func TestReduce(t *testing.T) {
+	add := func(acc, v int) int { return acc + v }
+	if v := slicex.Reduce([]int{1, 2, 3}, add).UnwrapOr(0); v != 6 {
+		t.Fatalf("expected 6, got %d", v)
+	}
+	if o := slicex.Reduce(nil, add); !o.IsNone() {
+		t.Fatal("expected None for empty slice")
+	}
+}
+
+func TestReduceRight(t *testing.T) {
+	concat := func(acc, v string) string { return acc + v }
+	if v := slicex.ReduceRight([]string{"a", "b", "c"}, concat).UnwrapOr(""); v != "cba" {
+		t.Fatalf("expected cba, got %q", v)
+	}
+	if o := slicex.ReduceRight(nil, concat); !o.IsNone() {
+		t.Fatal("expected None for empty slice")
+	}
+}
+
+func TestFold(t *testing.T) {
+	count := func(acc int, s string) int { return acc + len(s) }
+	if v := slicex.Fold([]string{"a", "bb"}, 10, count); v != 13 {
+		t.Fatalf("expected 13, got %d", v)
+	}
+	if v := slicex.Fold(nil, 10, count); v != 10 {
+		t.Fatalf("expected init for empty slice, got %d", v)
+	}
+}
+
+func TestScan(t *testing.T) {
+	add := func(acc, v int) int { return acc + v }
+	if got := slicex.Scan([]int{10, -3, 5}, 0, add); !slices.Equal(got, []int{10, 7, 12}) {
+		t.Fatalf("expected [10 7 12], got %v", got)
+	}
+	if got := slicex.Scan(nil, 0, add); len(got) != 0 {
+		t.Fatalf("expected empty result, got %v", got)
+	}
+}
+
+func TestFlatMap(t *testing.T) {
+	got := slicex.FlatMap([]int{1, 2}, func(i int) []int { return []int{i, i * 10} })
+	if !slices.Equal(got, []int{1, 10, 2, 20}) {
+		t.Fatalf("expected [1 10 2 20], got %v", got)
+	}
+}
+
+func TestForEach(t *testing.T) {
+	var seen []int
+	slicex.ForEach([]int{1, 2}, func(i int) { seen = append(seen, i) })
+	if !slices.Equal(seen, []int{1, 2}) {
+		t.Fatalf("expected [1 2], got %v", seen)
+	}
+}
+
+func TestContainsIndexOf(t *testing.T) {
+	items := []string{"a", "b"}
+	if !slicex.Contains(items, "b") || slicex.Contains(items, "c") {
+		t.Fatal("unexpected Contains result")
+	}
+	if slicex.IndexOf(items, "b") != 1 || slicex.IndexOf(items, "c") != -1 {
+		t.Fatal("unexpected IndexOf result")
+	}
+}
+
+func TestZipUnzip(t *testing.T) {
+	ps := slicex.Zip([]string{"a", "b", "c"}, []int{1, 2})
+	if len(ps) != 2 || ps[1] != anygo.NewPair("b", 2) {
+		t.Fatalf("unexpected pairs %v", ps)
+	}
+	as, bs := slicex.Unzip(ps)
+	if !slices.Equal(as, []string{"a", "b"}) || !slices.Equal(bs, []int{1, 2}) {
+		t.Fatalf("unexpected unzip %v %v", as, bs)
+	}
+}
+
+func TestZip3Unzip3(t *testing.T) {
+	ts := slicex.Zip3([]int{1, 2}, []string{"a", "b"}, []bool{true})
+	if len(ts) != 1 || ts[0] != anygo.NewTriple(1, "a", true) {
+		t.Fatalf("unexpected triples %v", ts)
+	}
+	as, bs, cs := slicex.Unzip3(ts)
+	if len(as) != 1 || bs[0] != "a" || !cs[0] {
+		t.Fatalf("unexpected unzip %v %v %v", as, bs, cs)
+	}
+}
@@ -0,0 +1,129 @@
+package slicex
+
+import (
+	"cmp"
+	"slices"
+
+	"github.com/daxartio/anygo"
+)
+
+// SortBy returns a sorted copy of items, ordered by less. The sort is not
+// guaranteed to be stable.
+//
+// Example:
+//
+//	byAge := slicex.SortBy(users, func(a, b User) bool { return a.Age < b.Age })
+func SortBy[T any](items []T, less func(a, b T) bool) []T {
+	out := slices.Clone(items)
+	slices.SortFunc(out, lessToCmp(less))
+	return out
+}
+
+// SortStableBy is like SortBy, but items that compare equal keep their input
+// order.
+func SortStableBy[T any](items []T, less func(a, b T) bool) []T {
+	out := slices.Clone(items)
+	slices.SortStableFunc(out, lessToCmp(less))
+	return out
+}
+
+func lessToCmp[T any](less func(a, b T) bool) func(a, b T) int {
+	return func(a, b T) int {
+		switch {
+		case less(a, b):
+			return -1
+		case less(b, a):
+			return 1
+		default:
+			return 0
+		}
+	}
+}
+
+// Comparator compares two values, returning a negative number if a sorts
+// before b, a positive number if it sorts after, and zero if they are equal.
+// It has the same shape as the functions accepted by slices.SortFunc.
+type Comparator[T any] func(a, b T) int
+
+// CompareBy returns a Comparator ordering values by the key returned by
+// keyFn.
+//
+// Example:
+//
+//	order := slicex.CompareBy(func(u User) string { return u.Last }).
+//		Then(slicex.CompareBy(func(u User) string { return u.First })).
+//		Then(slicex.CompareBy(func(u User) int { return u.Age }).Reversed())
+//	sorted := slicex.SortStableBy(users, order.Less)
+func CompareBy[T any, K cmp.Ordered](keyFn func(T) K) Comparator[T] {
+	return func(a, b T) int {
+		return cmp.Compare(keyFn(a), keyFn(b))
+	}
+}
+
+// Then returns a Comparator that orders by c and breaks ties with next.
+func (c Comparator[T]) Then(next Comparator[T]) Comparator[T] {
+	return func(a, b T) int {
+		if r := c(a, b); r != 0 {
+			return r
+		}
+		return next(a, b)
+	}
+}
+
+// Reversed returns a Comparator with the opposite order of c.
+func (c Comparator[T]) Reversed() Comparator[T] {
+	return func(a, b T) int {
+		return c(b, a)
+	}
+}
+
+// Less reports whether a sorts before b. It lets a Comparator be passed to
+// SortBy and SortStableBy.
+func (c Comparator[T]) Less(a, b T) bool {
+	return c(a, b) < 0
+}
+
+// BinarySearchBy returns the index of an item whose key equals target in
+// items sorted by ascending key, or None if there is none. If several items
+// match, the first is returned.
+//
+// Example:
+//
+//	i := slicex.BinarySearchBy(users, func(u User) int { return u.ID }, 42)
+func BinarySearchBy[T any, K cmp.Ordered](items []T, keyFn func(T) K, target K) anygo.Option[int] {
+	i, found := slices.BinarySearchFunc(items, target, func(v T, t K) int {
+		return cmp.Compare(keyFn(v), t)
+	})
+	if !found {
+		return anygo.None[int]()
+	}
+	return anygo.Some(i)
+}
+
+// InsertSorted inserts v into items sorted by ascending key, after any items
+// with an equal key, and returns the modified slice. Like append, it may
+// reuse the backing array of items.
+//
+// Example:
+//
+//	queue = slicex.InsertSorted(queue, job, func(j Job) time.Time { return j.Due })
+func InsertSorted[T any, K cmp.Ordered](items []T, v T, keyFn func(T) K) []T {
+	k := keyFn(v)
+	i, _ := slices.BinarySearchFunc(items, k, func(item T, t K) int {
+		if keyFn(item) <= t {
+			return -1
+		}
+		return 1
+	})
+	return slices.Insert(items, i, v)
+}
+
+// IsSortedBy reports whether items are sorted by ascending key.
+func IsSortedBy[T any, K cmp.Ordered](items []T, keyFn func(T) K) bool {
+	for i := 1; i < len(items); i++ {
+		if keyFn(items[i]) < keyFn(items[i-1]) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,76 @@
+package slicex_test
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/daxartio/anygo/slicex"
+)
+
+type person struct {
+	name string
+	age  int
+}
+
+func TestSortBy(t *testing.T) {
+	items := []int{3, 1, 2}
+	got := slicex.SortBy(items, func(a, b int) bool { return a < b })
+	if !slices.Equal(got, []int{1, 2, 3}) {
+		t.Fatalf("expected [1 2 3], got %v", got)
+	}
+	if !slices.Equal(items, []int{3, 1, 2}) {
+		t.Fatal("expected input to be left unchanged")
+	}
+}
+
+func TestSortStableBy(t *testing.T) {
+	people := []person{{"b", 30}, {"a", 20}, {"c", 30}}
+	got := slicex.SortStableBy(people, func(a, b person) bool { return a.age < b.age })
+	if !slices.Equal(got, []person{{"a", 20}, {"b", 30}, {"c", 30}}) {
+		t.Fatalf("unexpected order %v", got)
+	}
+}
+
+func TestComparator(t *testing.T) {
+	people := []person{{"b", 20}, {"a", 30}, {"a", 20}, {"c", 30}}
+	byAgeDesc := slicex.CompareBy(func(p person) int { return p.age }).Reversed()
+	byName := slicex.CompareBy(func(p person) string { return p.name })
+	got := slicex.SortBy(people, byAgeDesc.Then(byName).Less)
+	want := []person{{"a", 30}, {"c", 30}, {"a", 20}, {"b", 20}}
+	if !slices.Equal(got, want) {
+		t.Fatalf("expected %v, got %v", want, got)
+	}
+	slices.SortFunc(people, byName)
+	if people[0].name != "a" {
+		t.Fatal("expected Comparator to work with slices.SortFunc")
+	}
+}
+
+func TestBinarySearchBy(t *testing.T) {
+	people := []person{{"a", 20}, {"b", 25}, {"c", 25}, {"d", 40}}
+	age := func(p person) int { return p.age }
+	if i, ok := slicex.BinarySearchBy(people, age, 25).Unwrap(); !ok || i != 1 {
+		t.Fatalf("expected index 1, got %v %v", i, ok)
+	}
+	if slicex.BinarySearchBy(people, age, 30).IsSome() {
+		t.Fatal("expected None for a missing key")
+	}
+}
+
+func TestInsertSorted(t *testing.T) {
+	people := []person{{"a", 20}, {"b", 25}, {"d", 40}}
+	age := func(p person) int { return p.age }
+	people = slicex.InsertSorted(people, person{"c", 25}, age)
+	people = slicex.InsertSorted(people, person{"e", 50}, age)
+	people = slicex.InsertSorted(people, person{"f", 10}, age)
+	want := []person{{"f", 10}, {"a", 20}, {"b", 25}, {"c", 25}, {"d", 40}, {"e", 50}}
+	if !slices.Equal(people, want) {
+		t.Fatalf("expected %v, got %v", want, people)
+	}
+	if !slicex.IsSortedBy(people, age) {
+		t.Fatal("expected sorted")
+	}
+	if slicex.IsSortedBy([]int{1, 3, 2}, func(i int) int { return i }) {
+		t.Fatal("expected unsorted")
+	}
+}
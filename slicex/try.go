@@ -0,0 +1,60 @@
+package slicex
+
+import (
+	"strconv"
+
+	"github.com/daxartio/anygo"
+)
+
+// IndexError is an error annotated with the index of the item that caused
+// it.
+type IndexError struct {
+	Index int
+	Err   error
+}
+
+func (e *IndexError) Error() string {
+	return "index " + strconv.Itoa(e.Index) + ": " + e.Err.Error()
+}
+
+func (e *IndexError) Unwrap() error {
+	return e.Err
+}
+
+// ForEachE calls f for each item in order and stops at the first error,
+// returning it wrapped in an *IndexError.
+//
+// Example:
+//
+//	err := slicex.ForEachE(files, os.Remove)
+//	var ie *slicex.IndexError
+//	if errors.As(err, &ie) {
+//		log.Printf("failed at %s", files[ie.Index])
+//	}
+func ForEachE[T any](items []T, f func(T) error) error {
+	for i, v := range items {
+		if err := f(v); err != nil {
+			return &IndexError{Index: i, Err: err}
+		}
+	}
+	return nil
+}
+
+// TryMap applies f to each item and collects the values, stopping at the
+// first error and returning it wrapped in an *IndexError.
+//
+// Example:
+//
+//	r := slicex.TryMap([]string{"1", "x"}, strconv.Atoi)
+//	fmt.Println(r.UnwrapError()) // index 1: strconv.Atoi: parsing "x": invalid syntax
+func TryMap[T, U any](items []T, f func(T) (U, error)) anygo.Result[[]U] {
+	out := make([]U, 0, len(items))
+	for i, v := range items {
+		val, err := f(v)
+		if err != nil {
+			return anygo.Err[[]U](&IndexError{Index: i, Err: err})
+		}
+		out = append(out, val)
+	}
+	return anygo.Ok(out)
+}
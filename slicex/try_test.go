@@ -0,0 +1,50 @@
+package slicex_test
+
+import (
+	"errors"
+	"slices"
+	"strconv"
+	"testing"
+
+	"github.com/daxartio/anygo/slicex"
+)
+
+func TestForEachE(t *testing.T) {
+	fail := errors.New("fail")
+	var seen []int
+	err := slicex.ForEachE([]int{1, 2, 3}, func(i int) error {
+		seen = append(seen, i)
+		if i == 2 {
+			return fail
+		}
+		return nil
+	})
+	var ie *slicex.IndexError
+	if !errors.As(err, &ie) || ie.Index != 1 || !errors.Is(err, fail) {
+		t.Fatalf("expected IndexError at 1, got %v", err)
+	}
+	if !slices.Equal(seen, []int{1, 2}) {
+		t.Fatalf("expected to stop at the first error, saw %v", seen)
+	}
+	if err := slicex.ForEachE([]int{1}, func(int) error { return nil }); err != nil {
+		t.Fatalf("expected nil, got %v", err)
+	}
+}
+
+func TestTryMap(t *testing.T) {
+	if got := slicex.TryMap([]string{"1", "2"}, strconv.Atoi).MustUnwrap(); !slices.Equal(got, []int{1, 2}) {
+		t.Fatalf("expected [1 2], got %v", got)
+	}
+
+	r := slicex.TryMap([]string{"1", "x", "y"}, strconv.Atoi)
+	var ie *slicex.IndexError
+	if !errors.As(r.UnwrapError(), &ie) || ie.Index != 1 {
+		t.Fatalf("expected IndexError at 1, got %v", r.UnwrapError())
+	}
+	if !errors.Is(r.UnwrapError(), strconv.ErrSyntax) {
+		t.Fatalf("expected the cause to be preserved, got %v", r.UnwrapError())
+	}
+	if msg := r.UnwrapError().Error(); msg != `index 1: strconv.Atoi: parsing "x": invalid syntax` {
+		t.Fatalf("unexpected message %q", msg)
+	}
+}
@@ -0,0 +1,121 @@
+package anygo
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"runtime"
+	"sync/atomic"
+)
+
+// stackTraces is non-zero while Err records the call stack. It is a plain
+// uint32 rather than an atomic.Bool because the atomic.LoadUint32 intrinsic
+// is much cheaper in Err's inlining budget than the Bool.Load method.
+var stackTraces uint32
+
+// stackTracesEnabled reports whether Err records the call stack.
+func stackTracesEnabled() bool {
+	return atomic.LoadUint32(&stackTraces) != 0
+}
+
+// EnableStackTraces makes Err record the call stack of every new error.
+// Recording a stack has a cost, so it is disabled by default.
+func EnableStackTraces() {
+	atomic.StoreUint32(&stackTraces, 1)
+}
+
+// DisableStackTraces stops Err from recording call stacks.
+func DisableStackTraces() {
+	atomic.StoreUint32(&stackTraces, 0)
+}
+
+// stackError wraps an error with the call stack where it became an Err.
+type stackError struct {
+	err error
+	pcs []uintptr
+}
+
+func (e *stackError) Error() string {
+	return e.err.Error()
+}
+
+func (e *stackError) Unwrap() error {
+	return e.err
+}
+
+// Format prints the stack after the error when formatted with %+v.
+func (e *stackError) Format(s fmt.State, verb rune) {
+	switch verb {
+	case 'v':
+		if s.Flag('+') {
+			fmt.Fprintf(s, "%+v", e.err)
+			writeFrames(s, e.frames())
+			return
+		}
+		io.WriteString(s, e.Error())
+	case 's':
+		io.WriteString(s, e.Error())
+	case 'q':
+		fmt.Fprintf(s, "%q", e.Error())
+	}
+}
+
+func (e *stackError) frames() []runtime.Frame {
+	var frames []runtime.Frame
+	it := runtime.CallersFrames(e.pcs)
+	for {
+		frame, more := it.Next()
+		frames = append(frames, frame)
+		if !more {
+			return frames
+		}
+	}
+}
+
+// writeFrames prints one frame per entry, function name then location.
+func writeFrames(w io.Writer, frames []runtime.Frame) {
+	for _, f := range frames {
+		fmt.Fprintf(w, "\n%s\n\t%s:%d", f.Function, f.File, f.Line)
+	}
+}
+
+// withStack wraps err with the stack of the caller skip frames above
+// withStack's caller, unless err already carries a stack.
+func withStack(err error, skip int) error {
+	var se *stackError
+	if err == nil || errors.As(err, &se) {
+		return err
+	}
+	pcs := make([]uintptr, 32)
+	n := runtime.Callers(skip+2, pcs)
+	return &stackError{err: err, pcs: pcs[:n]}
+}
+
+// errWithStack records the stack for Err. It is kept out of line so that
+// Err stays cheap enough to inline while stack traces are off.
+//
+//go:noinline
+func errWithStack(err error) error {
+	return withStack(err, 2)
+}
+
+// ErrWithStack returns a failed Result whose error records the call stack,
+// regardless of EnableStackTraces.
+//
+// Example:
+//
+//	r := anygo.ErrWithStack[int](errors.New("oops"))
+//	fmt.Printf("%+v\n", r.UnwrapError())
+func ErrWithStack[T any](err error) Result[T] {
+	return Result[T]{err: withStack(err, 1)}
+}
+
+// Stack returns the call stack recorded for the error, or nil if the Result
+// is Ok or no stack was recorded.
+func (r Result[T]) Stack() []runtime.Frame {
+	var se *stackError
+	if !errors.As(r.err, &se) {
+		return nil
+	}
+	return se.frames()
+}
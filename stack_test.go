@@ -0,0 +1,47 @@
+package anygo_test
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/daxartio/anygo"
+)
+
+func TestErrWithStack(t *testing.T) {
+	sentinel := errors.New("oops")
+	r := anygo.ErrWithStack[int](sentinel)
+	if !r.Is(sentinel) || r.UnwrapError().Error() != "oops" {
+		t.Fatal("expected error to wrap the original")
+	}
+	frames := r.Stack()
+	if len(frames) == 0 || !strings.HasSuffix(frames[0].Function, "TestErrWithStack") {
+		t.Fatalf("expected stack to start at the caller, got %v", frames)
+	}
+
+	formatted := fmt.Sprintf("%+v", r.UnwrapError())
+	if !strings.HasPrefix(formatted, "oops\n") || !strings.Contains(formatted, "stack_test.go") {
+		t.Fatalf("expected stack in %%+v output, got %q", formatted)
+	}
+	if plain := fmt.Sprintf("%v", r.UnwrapError()); plain != "oops" {
+		t.Fatalf("expected plain message for %%v, got %q", plain)
+	}
+}
+
+func TestEnableStackTraces(t *testing.T) {
+	if anygo.Err[int](errors.New("fail")).Stack() != nil {
+		t.Fatal("expected no stack by default")
+	}
+
+	anygo.EnableStackTraces()
+	defer anygo.DisableStackTraces()
+
+	r := anygo.Err[int](errors.New("fail"))
+	if frames := r.Stack(); len(frames) == 0 || !strings.HasSuffix(frames[0].Function, "TestEnableStackTraces") {
+		t.Fatalf("expected stack to start at the caller of Err, got %v", frames)
+	}
+	if anygo.Ok(1).Stack() != nil {
+		t.Fatal("expected no stack for Ok")
+	}
+}
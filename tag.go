@@ -0,0 +1,57 @@
+package anygo
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+)
+
+// UnwrapOrTag returns r if Ok. If r is Err, it parses tagDefault, typically
+// the value of a `default:"..."` struct tag, into T and returns it as Ok.
+// Strings, booleans, integers and floats are supported. If tagDefault cannot
+// be parsed, the original Err is returned; if T is of another kind, an Err
+// describing the unsupported type is returned.
+//
+// Example:
+//
+//	field, _ := reflect.TypeOf(Config{}).FieldByName("Port")
+//	port := anygo.UnwrapOrTag(lookupPort(), field.Tag.Get("default"))
+func UnwrapOrTag[T any](r Result[T], tagDefault string) Result[T] {
+	if r.IsOk() {
+		return r
+	}
+
+	var val T
+	rv := reflect.ValueOf(&val).Elem()
+	switch rv.Kind() {
+	case reflect.String:
+		rv.SetString(tagDefault)
+	case reflect.Bool:
+		b, err := strconv.ParseBool(tagDefault)
+		if err != nil {
+			return r
+		}
+		rv.SetBool(b)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		i, err := strconv.ParseInt(tagDefault, 10, rv.Type().Bits())
+		if err != nil {
+			return r
+		}
+		rv.SetInt(i)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		u, err := strconv.ParseUint(tagDefault, 10, rv.Type().Bits())
+		if err != nil {
+			return r
+		}
+		rv.SetUint(u)
+	case reflect.Float32, reflect.Float64:
+		f, err := strconv.ParseFloat(tagDefault, rv.Type().Bits())
+		if err != nil {
+			return r
+		}
+		rv.SetFloat(f)
+	default:
+		return Err[T](fmt.Errorf("anygo: unsupported default type %s", rv.Type()))
+	}
+	return Ok(val)
+}
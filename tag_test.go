@@ -0,0 +1,52 @@
+package anygo_test
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/daxartio/anygo"
+)
+
+type tagConfig struct {
+	Port  int     `default:"8080"`
+	Host  string  `default:"localhost"`
+	Debug bool    `default:"true"`
+	Ratio float64 `default:"0.5"`
+}
+
+func tagDefault(name string) string {
+	field, _ := reflect.TypeOf(tagConfig{}).FieldByName(name)
+	return field.Tag.Get("default")
+}
+
+func TestUnwrapOrTag(t *testing.T) {
+	fail := errors.New("missing")
+	if v := anygo.UnwrapOrTag(anygo.Err[int](fail), tagDefault("Port")).MustUnwrap(); v != 8080 {
+		t.Fatalf("expected 8080, got %d", v)
+	}
+	if v := anygo.UnwrapOrTag(anygo.Err[string](fail), tagDefault("Host")).MustUnwrap(); v != "localhost" {
+		t.Fatalf("expected 'localhost', got %q", v)
+	}
+	if v := anygo.UnwrapOrTag(anygo.Err[bool](fail), tagDefault("Debug")).MustUnwrap(); !v {
+		t.Fatal("expected true")
+	}
+	if v := anygo.UnwrapOrTag(anygo.Err[float64](fail), tagDefault("Ratio")).MustUnwrap(); v != 0.5 {
+		t.Fatalf("expected 0.5, got %v", v)
+	}
+	if v := anygo.UnwrapOrTag(anygo.Ok(1), "8080").MustUnwrap(); v != 1 {
+		t.Fatal("expected Ok to pass through")
+	}
+}
+
+func TestUnwrapOrTagInvalidDefault(t *testing.T) {
+	fail := errors.New("missing")
+	if r := anygo.UnwrapOrTag(anygo.Err[int](fail), "abc"); r.UnwrapError() != fail {
+		t.Fatal("expected original error")
+	}
+
+	r := anygo.UnwrapOrTag(anygo.Err[[]int](fail), "1")
+	if !r.IsErr() || r.UnwrapError() == fail {
+		t.Fatal("expected unsupported type error")
+	}
+}
@@ -0,0 +1,88 @@
+package anygo
+
+import (
+	"encoding"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// errTextPrefix marks an Err Result in its text form.
+const errTextPrefix = "error: "
+
+// okTextEscape is prepended to Ok text that would otherwise read as an Err
+// or as already escaped.
+const okTextEscape = `\`
+
+// MarshalText implements encoding.TextMarshaler. An Ok Result is marshaled
+// through the value's own MarshalText if it has one, or fmt otherwise.
+// An Err Result is marshaled as the error message prefixed with "error: ".
+// Ok text that starts with "error: " or a backslash is escaped with a
+// leading backslash so that it round-trips through UnmarshalText.
+//
+// Example:
+//
+//	b, _ := anygo.Ok(42).MarshalText()
+//	fmt.Println(string(b)) // "42"
+func (r Result[T]) MarshalText() ([]byte, error) {
+	if r.IsErr() {
+		return []byte(errTextPrefix + r.err.Error()), nil
+	}
+	var text []byte
+	if m, ok := any(r.value).(encoding.TextMarshaler); ok {
+		b, err := m.MarshalText()
+		if err != nil {
+			return nil, err
+		}
+		text = b
+	} else {
+		text = []byte(fmt.Sprint(r.value))
+	}
+	if s := string(text); strings.HasPrefix(s, errTextPrefix) || strings.HasPrefix(s, okTextEscape) {
+		text = append([]byte(okTextEscape), text...)
+	}
+	return text, nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. Text prefixed with
+// "error: " becomes an Err Result carrying the rest of the text as its
+// message. Any other text, with a leading backslash escape removed, is
+// parsed into the value, through the value's own UnmarshalText if it has
+// one, or fmt otherwise.
+func (r *Result[T]) UnmarshalText(text []byte) error {
+	s := string(text)
+	if msg, ok := strings.CutPrefix(s, errTextPrefix); ok {
+		*r = Err[T](errors.New(msg))
+		return nil
+	}
+	if unescaped, ok := strings.CutPrefix(s, okTextEscape); ok {
+		s, text = unescaped, []byte(unescaped)
+	}
+
+	var val T
+	if u, ok := any(&val).(encoding.TextUnmarshaler); ok {
+		if err := u.UnmarshalText(text); err != nil {
+			return err
+		}
+		*r = Ok(val)
+		return nil
+	}
+
+	rv := reflect.ValueOf(&val).Elem()
+	if rv.Kind() == reflect.String {
+		rv.SetString(s)
+		*r = Ok(val)
+		return nil
+	}
+
+	rd := strings.NewReader(s)
+	if _, err := fmt.Fscan(rd, &val); err != nil {
+		return fmt.Errorf("anygo: cannot parse %q as %T: %w", s, val, err)
+	}
+	if rd.Len() > 0 {
+		return fmt.Errorf("anygo: cannot parse %q as %T: unexpected trailing text", s, val)
+	}
+	*r = Ok(val)
+	return nil
+}
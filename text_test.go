@@ -0,0 +1,67 @@
+package anygo_test
+
+import (
+	"errors"
+	"net/netip"
+	"testing"
+
+	"github.com/daxartio/anygo"
+)
+
+func TestMarshalText(t *testing.T) {
+	b, err := anygo.Ok(42).MarshalText()
+	if err != nil || string(b) != "42" {
+		t.Fatalf("expected '42', got '%s' (%v)", b, err)
+	}
+
+	b, _ = anygo.Ok(netip.MustParseAddr("10.0.0.1")).MarshalText()
+	if string(b) != "10.0.0.1" {
+		t.Fatalf("expected TextMarshaler to be used, got '%s'", b)
+	}
+
+	b, _ = anygo.Err[int](errors.New("bad")).MarshalText()
+	if string(b) != "error: bad" {
+		t.Fatalf("expected 'error: bad', got '%s'", b)
+	}
+}
+
+func TestUnmarshalText(t *testing.T) {
+	var r anygo.Result[int]
+	if err := r.UnmarshalText([]byte("42")); err != nil || r.MustUnwrap() != 42 {
+		t.Fatalf("expected Ok(42), got %v (%v)", r.UnwrapOr(0), err)
+	}
+
+	var s anygo.Result[string]
+	if err := s.UnmarshalText([]byte("hello world")); err != nil || s.MustUnwrap() != "hello world" {
+		t.Fatal("expected whole text as string value")
+	}
+
+	var addr anygo.Result[netip.Addr]
+	if err := addr.UnmarshalText([]byte("10.0.0.1")); err != nil || addr.MustUnwrap().String() != "10.0.0.1" {
+		t.Fatal("expected TextUnmarshaler to be used")
+	}
+
+	if err := r.UnmarshalText([]byte("error: bad")); err != nil || r.UnwrapError().Error() != "bad" {
+		t.Fatal("expected Err result with message 'bad'")
+	}
+
+	if err := r.UnmarshalText([]byte("12abc")); err == nil {
+		t.Fatal("expected parse error")
+	}
+}
+
+func TestTextRoundTripEscapesOk(t *testing.T) {
+	for _, v := range []string{"error: x", `\error: x`, `\`, "plain"} {
+		b, err := anygo.Ok(v).MarshalText()
+		if err != nil {
+			t.Fatal(err)
+		}
+		var r anygo.Result[string]
+		if err := r.UnmarshalText(b); err != nil {
+			t.Fatal(err)
+		}
+		if got, err := r.Unwrap(); err != nil || got != v {
+			t.Fatalf("expected Ok(%q) to round-trip via %q, got %v", v, b, r)
+		}
+	}
+}
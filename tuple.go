@@ -0,0 +1,57 @@
+package anygo
+
+// Pair holds two values.
+type Pair[A, B any] struct {
+	First  A
+	Second B
+}
+
+// NewPair returns a Pair of a and b.
+//
+// Example:
+//
+//	p := anygo.NewPair("a", 1)
+//	k, v := p.Unpack()
+func NewPair[A, B any](a A, b B) Pair[A, B] {
+	return Pair[A, B]{First: a, Second: b}
+}
+
+// Unpack returns the values of the Pair.
+func (p Pair[A, B]) Unpack() (A, B) {
+	return p.First, p.Second
+}
+
+// Triple holds three values.
+type Triple[A, B, C any] struct {
+	First  A
+	Second B
+	Third  C
+}
+
+// NewTriple returns a Triple of a, b and c.
+func NewTriple[A, B, C any](a A, b B, c C) Triple[A, B, C] {
+	return Triple[A, B, C]{First: a, Second: b, Third: c}
+}
+
+// Unpack returns the values of the Triple.
+func (t Triple[A, B, C]) Unpack() (A, B, C) {
+	return t.First, t.Second, t.Third
+}
+
+// Quad holds four values.
+type Quad[A, B, C, D any] struct {
+	First  A
+	Second B
+	Third  C
+	Fourth D
+}
+
+// NewQuad returns a Quad of a, b, c and d.
+func NewQuad[A, B, C, D any](a A, b B, c C, d D) Quad[A, B, C, D] {
+	return Quad[A, B, C, D]{First: a, Second: b, Third: c, Fourth: d}
+}
+
+// Unpack returns the values of the Quad.
+func (q Quad[A, B, C, D]) Unpack() (A, B, C, D) {
+	return q.First, q.Second, q.Third, q.Fourth
+}
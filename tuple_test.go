@@ -0,0 +1,28 @@
+package anygo_test
+
+import (
+	"testing"
+
+	"github.com/daxartio/anygo"
+)
+
+func TestPair(t *testing.T) {
+	a, b := anygo.NewPair("a", 1).Unpack()
+	if a != "a" || b != 1 {
+		t.Fatalf("unexpected values %v %v", a, b)
+	}
+}
+
+func TestTriple(t *testing.T) {
+	a, b, c := anygo.NewTriple(1, "b", true).Unpack()
+	if a != 1 || b != "b" || !c {
+		t.Fatalf("unexpected values %v %v %v", a, b, c)
+	}
+}
+
+func TestQuad(t *testing.T) {
+	a, b, c, d := anygo.NewQuad(1, "b", true, 4.0).Unpack()
+	if a != 1 || b != "b" || !c || d != 4.0 {
+		t.Fatalf("unexpected values %v %v %v %v", a, b, c, d)
+	}
+}
@@ -0,0 +1,148 @@
+package anygo
+
+import "errors"
+
+// Validator runs a list of checks against a value and records the first
+// failure.
+type Validator[T any] struct {
+	value T
+	err   error
+}
+
+// NewValidator returns a Validator for val with no recorded failure.
+//
+// Example:
+//
+//	r := anygo.NewValidator(user).
+//		Check(func(u User) bool { return u.Name != "" }, errors.New("name is required")).
+//		Check(func(u User) bool { return u.Age >= 18 }, errors.New("must be an adult")).
+//		Result()
+func NewValidator[T any](val T) Validator[T] {
+	return Validator[T]{value: val}
+}
+
+// Check records err if pred returns false for the value. Once a check has
+// failed, later checks are skipped.
+func (v Validator[T]) Check(pred func(T) bool, err error) Validator[T] {
+	if v.err != nil || pred(v.value) {
+		return v
+	}
+	v.err = err
+	return v
+}
+
+// Result returns Ok with the value if all checks passed, or Err with the
+// first failure otherwise.
+func (v Validator[T]) Result() Result[T] {
+	if v.err != nil {
+		return Err[T](v.err)
+	}
+	return Ok(v.value)
+}
+
+// FieldError is a validation error annotated with the path of the field it
+// refers to.
+type FieldError struct {
+	Path string
+	Err  error
+}
+
+func (e *FieldError) Error() string {
+	return e.Path + ": " + e.Err.Error()
+}
+
+func (e *FieldError) Unwrap() error {
+	return e.Err
+}
+
+// Validated holds a value together with every validation failure recorded
+// against it. Unlike Validator, it does not stop at the first failure.
+type Validated[T any] struct {
+	value T
+	errs  []error
+}
+
+// Validate returns a Validated for val with no recorded failures.
+//
+// Example:
+//
+//	r := anygo.Validate(form).
+//		CheckField("name", func(f Form) bool { return f.Name != "" }, errRequired).
+//		CheckField("email", func(f Form) bool { return strings.Contains(f.Email, "@") }, errInvalid).
+//		Result()
+func Validate[T any](val T) Validated[T] {
+	return Validated[T]{value: val}
+}
+
+// Check records err if pred returns false for the value.
+func (v Validated[T]) Check(pred func(T) bool, err error) Validated[T] {
+	if pred(v.value) {
+		return v
+	}
+	return v.withErr(err)
+}
+
+// CheckField records err annotated with path if pred returns false for the
+// value.
+func (v Validated[T]) CheckField(path string, pred func(T) bool, err error) Validated[T] {
+	if pred(v.value) {
+		return v
+	}
+	return v.withErr(&FieldError{Path: path, Err: err})
+}
+
+// Prefix prepends prefix to the path of every recorded FieldError, joined
+// with a dot. Other errors become FieldErrors with path prefix. It is used
+// to nest the validation of a sub-value under its field name.
+func (v Validated[T]) Prefix(prefix string) Validated[T] {
+	errs := make([]error, len(v.errs))
+	for i, err := range v.errs {
+		if fe, ok := err.(*FieldError); ok {
+			errs[i] = &FieldError{Path: prefix + "." + fe.Path, Err: fe.Err}
+			continue
+		}
+		errs[i] = &FieldError{Path: prefix, Err: err}
+	}
+	v.errs = errs
+	return v
+}
+
+// IsValid returns true if no failures were recorded.
+func (v Validated[T]) IsValid() bool {
+	return len(v.errs) == 0
+}
+
+// Errors returns the recorded failures in the order they were recorded.
+func (v Validated[T]) Errors() []error {
+	return v.errs
+}
+
+// Result returns Ok with the value if no failures were recorded, or Err with
+// all failures joined by errors.Join otherwise.
+func (v Validated[T]) Result() Result[T] {
+	if v.IsValid() {
+		return Ok(v.value)
+	}
+	return Err[T](errors.Join(v.errs...))
+}
+
+// withErr returns a copy of v with err appended, leaving v untouched.
+func (v Validated[T]) withErr(err error) Validated[T] {
+	v.errs = append(v.errs[:len(v.errs):len(v.errs)], err)
+	return v
+}
+
+// Combine applies f to the values of a and b, keeping the failures of both.
+//
+// Example:
+//
+//	user := anygo.Combine(validateName(in.Name).Prefix("name"), validateAge(in.Age).Prefix("age"), newUser)
+func Combine[A, B, R any](a Validated[A], b Validated[B], f func(A, B) R) Validated[R] {
+	errs := make([]error, 0, len(a.errs)+len(b.errs))
+	errs = append(errs, a.errs...)
+	errs = append(errs, b.errs...)
+	if len(errs) > 0 {
+		return Validated[R]{errs: errs}
+	}
+	return Validated[R]{value: f(a.value, b.value)}
+}
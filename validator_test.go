@@ -0,0 +1,80 @@
+package anygo_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/daxartio/anygo"
+)
+
+func TestValidator(t *testing.T) {
+	r := anygo.NewValidator(10).
+		Check(func(i int) bool { return i > 0 }, errors.New("not positive")).
+		Check(func(i int) bool { return i%2 == 0 }, errors.New("not even")).
+		Result()
+	if v := r.MustUnwrap(); v != 10 {
+		t.Fatalf("expected 10, got %d", v)
+	}
+}
+
+func TestValidatorFirstFailure(t *testing.T) {
+	first := errors.New("not even")
+	called := false
+	r := anygo.NewValidator(3).
+		Check(func(i int) bool { return i%2 == 0 }, first).
+		Check(func(i int) bool { called = true; return false }, errors.New("second")).
+		Result()
+	if r.UnwrapError() != first {
+		t.Fatalf("expected first failure, got %v", r.UnwrapError())
+	}
+	if called {
+		t.Fatal("expected later checks to be skipped")
+	}
+}
+
+func TestValidate(t *testing.T) {
+	positive, even := errors.New("not positive"), errors.New("not even")
+	v := anygo.Validate(-3).
+		Check(func(i int) bool { return i > 0 }, positive).
+		Check(func(i int) bool { return i%2 == 0 }, even)
+	if v.IsValid() || len(v.Errors()) != 2 {
+		t.Fatalf("expected both failures, got %v", v.Errors())
+	}
+	err := v.Result().UnwrapError()
+	if !errors.Is(err, positive) || !errors.Is(err, even) {
+		t.Fatalf("expected joined error, got %v", err)
+	}
+
+	if r := anygo.Validate(2).Check(func(i int) bool { return i > 0 }, positive).Result(); r.MustUnwrap() != 2 {
+		t.Fatal("expected Ok result")
+	}
+}
+
+func TestValidateFieldPaths(t *testing.T) {
+	required := errors.New("required")
+	v := anygo.Validate("").
+		CheckField("street", func(s string) bool { return s != "" }, required).
+		Prefix("address")
+	var fe *anygo.FieldError
+	if !errors.As(v.Errors()[0], &fe) || fe.Path != "address.street" {
+		t.Fatalf("unexpected field error %v", v.Errors())
+	}
+	if msg := v.Errors()[0].Error(); msg != "address.street: required" {
+		t.Fatalf("unexpected message '%s'", msg)
+	}
+}
+
+func TestCombine(t *testing.T) {
+	errName, errAge := errors.New("bad name"), errors.New("bad age")
+	name := anygo.Validate("").Check(func(s string) bool { return s != "" }, errName)
+	age := anygo.Validate(-1).Check(func(i int) bool { return i >= 0 }, errAge)
+	v := anygo.Combine(name, age, func(n string, a int) string { return n })
+	if len(v.Errors()) != 2 {
+		t.Fatalf("expected failures from both sides, got %v", v.Errors())
+	}
+
+	ok := anygo.Combine(anygo.Validate("a"), anygo.Validate(1), func(n string, a int) string { return n + "1" })
+	if r := ok.Result(); r.MustUnwrap() != "a1" {
+		t.Fatalf("unexpected combined value %v", r)
+	}
+}
@@ -0,0 +1,166 @@
+package anygo
+
+import (
+	"context"
+	"errors"
+	"sync"
+)
+
+// ErrPoolClosed is returned by WorkerPool.Submit after Close has been called.
+var ErrPoolClosed = errors.New("anygo: worker pool closed")
+
+// WorkerPoolConfig configures a WorkerPool.
+type WorkerPoolConfig struct {
+	// Workers is the number of goroutines processing jobs. Values below 1
+	// mean a single worker.
+	Workers int
+	// Ordered makes Results deliver outputs in submission order. Otherwise
+	// outputs are delivered as soon as each job finishes.
+	Ordered bool
+}
+
+// WorkerPool processes submitted jobs with a fixed number of goroutines and
+// streams their Results. It is safe for concurrent use.
+//
+// Results must be drained until it is closed, otherwise workers block once
+// the output is full.
+type WorkerPool[T, U any] struct {
+	ctx     context.Context
+	f       func(context.Context, T) Result[U]
+	mu      sync.RWMutex // held for reading while submitting, for writing by Close
+	closed  bool
+	seqMu   sync.Mutex
+	seq     int
+	jobs    chan poolJob[T]
+	outs    chan poolOut[U]
+	results chan Result[U]
+	workers sync.WaitGroup
+}
+
+type poolJob[T any] struct {
+	seq int
+	v   T
+}
+
+type poolOut[U any] struct {
+	seq  int
+	r    Result[U]
+	skip bool // the job with this seq was never submitted
+}
+
+// NewWorkerPool starts a WorkerPool that calls f for every submitted job with
+// ctx. A panic inside f is recovered and delivered as an Err for that job.
+//
+// Example:
+//
+//	p := anygo.NewWorkerPool(ctx, anygo.WorkerPoolConfig{Workers: 8}, resize)
+//	go func() {
+//		defer p.Close()
+//		for _, img := range images {
+//			p.Submit(img)
+//		}
+//	}()
+//	for r := range p.Results() {
+//		// handle r
+//	}
+func NewWorkerPool[T, U any](ctx context.Context, cfg WorkerPoolConfig, f func(context.Context, T) Result[U]) *WorkerPool[T, U] {
+	workers := max(1, cfg.Workers)
+	p := &WorkerPool[T, U]{
+		ctx:     ctx,
+		f:       f,
+		jobs:    make(chan poolJob[T]),
+		outs:    make(chan poolOut[U], workers),
+		results: make(chan Result[U], workers),
+	}
+	for range workers {
+		p.workers.Add(1)
+		go p.work()
+	}
+	go func() {
+		p.workers.Wait()
+		close(p.outs)
+	}()
+	go p.deliver(cfg.Ordered)
+	return p
+}
+
+// Submit queues v for processing, blocking until a worker accepts it. It
+// returns ErrPoolClosed after Close, or ctx.Err() if the pool's context is
+// done first.
+func (p *WorkerPool[T, U]) Submit(v T) error {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	if p.closed {
+		return ErrPoolClosed
+	}
+
+	p.seqMu.Lock()
+	seq := p.seq
+	p.seq++
+	p.seqMu.Unlock()
+
+	select {
+	case p.jobs <- poolJob[T]{seq: seq, v: v}:
+		return nil
+	case <-p.ctx.Done():
+		// Workers are still running while Submit holds the read lock, so
+		// outs is open; tell the ordered delivery not to wait for seq.
+		p.outs <- poolOut[U]{seq: seq, skip: true}
+		return p.ctx.Err()
+	}
+}
+
+// Results returns the channel of job outputs. It is closed after Close once
+// every submitted job has finished.
+func (p *WorkerPool[T, U]) Results() <-chan Result[U] {
+	return p.results
+}
+
+// Close stops accepting jobs. Jobs already submitted still run and their
+// Results are delivered before the Results channel is closed. Close is safe
+// to call more than once.
+func (p *WorkerPool[T, U]) Close() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if !p.closed {
+		p.closed = true
+		close(p.jobs)
+	}
+}
+
+func (p *WorkerPool[T, U]) work() {
+	defer p.workers.Done()
+	for j := range p.jobs {
+		r := FromFunc(func() (U, error) { return p.f(p.ctx, j.v).Unwrap() })
+		p.outs <- poolOut[U]{seq: j.seq, r: r}
+	}
+}
+
+func (p *WorkerPool[T, U]) deliver(ordered bool) {
+	defer close(p.results)
+	if !ordered {
+		for o := range p.outs {
+			if !o.skip {
+				p.results <- o.r
+			}
+		}
+		return
+	}
+
+	pending := make(map[int]poolOut[U])
+	next := 0
+	for o := range p.outs {
+		pending[o.seq] = o
+		for {
+			o, ok := pending[next]
+			if !ok {
+				break
+			}
+			delete(pending, next)
+			next++
+			if !o.skip {
+				p.results <- o.r
+			}
+		}
+	}
+}
@@ -0,0 +1,101 @@
+package anygo_test
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/daxartio/anygo"
+)
+
+func TestWorkerPoolOrdered(t *testing.T) {
+	p := anygo.NewWorkerPool(context.Background(), anygo.WorkerPoolConfig{Workers: 4, Ordered: true},
+		func(_ context.Context, i int) anygo.Result[int] {
+			time.Sleep(time.Duration(10-i) * time.Millisecond)
+			return anygo.Ok(i * i)
+		})
+	go func() {
+		defer p.Close()
+		for i := range 10 {
+			if err := p.Submit(i); err != nil {
+				t.Errorf("unexpected submit error %v", err)
+			}
+		}
+	}()
+	var got []int
+	for r := range p.Results() {
+		got = append(got, r.MustUnwrap())
+	}
+	if !slices.Equal(got, []int{0, 1, 4, 9, 16, 25, 36, 49, 64, 81}) {
+		t.Fatalf("unexpected ordered results %v", got)
+	}
+}
+
+func TestWorkerPoolUnordered(t *testing.T) {
+	p := anygo.NewWorkerPool(context.Background(), anygo.WorkerPoolConfig{Workers: 3},
+		func(_ context.Context, i int) anygo.Result[int] { return anygo.Ok(i) })
+	go func() {
+		defer p.Close()
+		for i := range 20 {
+			p.Submit(i)
+		}
+	}()
+	var got []int
+	for r := range p.Results() {
+		got = append(got, r.MustUnwrap())
+	}
+	slices.Sort(got)
+	if len(got) != 20 || got[0] != 0 || got[19] != 19 {
+		t.Fatalf("unexpected results %v", got)
+	}
+}
+
+func TestWorkerPoolPanicAndClose(t *testing.T) {
+	p := anygo.NewWorkerPool(context.Background(), anygo.WorkerPoolConfig{},
+		func(_ context.Context, s string) anygo.Result[int] { panic(s) })
+	go func() {
+		p.Submit("boom")
+		p.Close()
+		p.Close()
+	}()
+	var pe *anygo.PanicError
+	for r := range p.Results() {
+		if !errors.As(r.UnwrapError(), &pe) || pe.Value != "boom" {
+			t.Fatalf("expected PanicError, got %v", r)
+		}
+	}
+	if pe == nil {
+		t.Fatal("expected a result")
+	}
+	if err := p.Submit("late"); !errors.Is(err, anygo.ErrPoolClosed) {
+		t.Fatalf("expected ErrPoolClosed, got %v", err)
+	}
+}
+
+func TestWorkerPoolCanceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	block := make(chan struct{})
+	p := anygo.NewWorkerPool(ctx, anygo.WorkerPoolConfig{Workers: 1, Ordered: true},
+		func(_ context.Context, i int) anygo.Result[int] {
+			<-block
+			return anygo.Ok(i)
+		})
+	if err := p.Submit(1); err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+	cancel()
+	if err := p.Submit(2); !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected context.Canceled, got %v", err)
+	}
+	close(block)
+	p.Close()
+	var got []int
+	for r := range p.Results() {
+		got = append(got, r.MustUnwrap())
+	}
+	if !slices.Equal(got, []int{1}) {
+		t.Fatalf("expected only the accepted job, got %v", got)
+	}
+}